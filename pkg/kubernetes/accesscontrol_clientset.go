@@ -3,6 +3,7 @@ package kubernetes
 import (
 	"context"
 	"fmt"
+	"net/http"
 
 	authenticationv1api "k8s.io/api/authentication/v1"
 	authorizationv1api "k8s.io/api/authorization/v1"
@@ -12,11 +13,16 @@ import (
 	"k8s.io/apimachinery/pkg/util/httpstream"
 	"k8s.io/client-go/discovery"
 	"k8s.io/client-go/kubernetes"
+	appsv1 "k8s.io/client-go/kubernetes/typed/apps/v1"
 	authenticationv1 "k8s.io/client-go/kubernetes/typed/authentication/v1"
 	authorizationv1 "k8s.io/client-go/kubernetes/typed/authorization/v1"
 	corev1 "k8s.io/client-go/kubernetes/typed/core/v1"
+	policyv1 "k8s.io/client-go/kubernetes/typed/policy/v1"
+	policyv1beta1 "k8s.io/client-go/kubernetes/typed/policy/v1beta1"
 	"k8s.io/client-go/rest"
+	"k8s.io/client-go/tools/portforward"
 	"k8s.io/client-go/tools/remotecommand"
+	"k8s.io/client-go/transport/spdy"
 	"k8s.io/metrics/pkg/apis/metrics"
 	metricsv1beta1api "k8s.io/metrics/pkg/apis/metrics/v1beta1"
 	metricsv1beta1 "k8s.io/metrics/pkg/client/clientset/versioned/typed/metrics/v1beta1"
@@ -74,6 +80,40 @@ func (a *AccessControlClientset) PodsExec(namespace, name string, podExecOptions
 	})
 }
 
+func (a *AccessControlClientset) PodsUpdateEphemeralContainers(ctx context.Context, pod *v1.Pod) (*v1.Pod, error) {
+	gvk := &schema.GroupVersionKind{Group: "", Version: "v1", Kind: "Pod"}
+	if !isAllowed(a.staticConfig, gvk) {
+		return nil, isNotAllowedError(gvk)
+	}
+	return a.delegate.CoreV1().Pods(pod.Namespace).UpdateEphemeralContainers(ctx, pod.Name, pod, metav1.UpdateOptions{})
+}
+
+func (a *AccessControlClientset) PodsPortForward(namespace, name string) (httpstream.Dialer, error) {
+	gvk := &schema.GroupVersionKind{Group: "", Version: "v1", Kind: "Pod"}
+	if !isAllowed(a.staticConfig, gvk) {
+		return nil, isNotAllowedError(gvk)
+	}
+	// https://github.com/kubernetes/kubectl/blob/5366de04e168bcbc11f5e340d131a9ca8b7d0df4/pkg/cmd/portforward/portforward.go#L139-L162
+	portForwardRequest := a.delegate.CoreV1().RESTClient().
+		Post().
+		Resource("pods").
+		Namespace(namespace).
+		Name(name).
+		SubResource("portforward")
+	transport, upgrader, err := spdy.RoundTripperFor(a.cfg)
+	if err != nil {
+		return nil, err
+	}
+	spdyDialer := spdy.NewDialer(upgrader, &http.Client{Transport: transport}, "POST", portForwardRequest.URL())
+	tunnelingDialer, err := portforward.NewSPDYOverWebsocketDialer(portForwardRequest.URL(), a.cfg)
+	if err != nil {
+		return nil, err
+	}
+	return portforward.NewFallbackDialer(tunnelingDialer, spdyDialer, func(err error) bool {
+		return httpstream.IsUpgradeFailure(err) || httpstream.IsHTTPSProxyError(err)
+	}), nil
+}
+
 func (a *AccessControlClientset) PodsMetricses(ctx context.Context, namespace, name string, listOptions metav1.ListOptions) (*metrics.PodMetricsList, error) {
 	gvk := &schema.GroupVersionKind{Group: metrics.GroupName, Version: metricsv1beta1api.SchemeGroupVersion.Version, Kind: "PodMetrics"}
 	if !isAllowed(a.staticConfig, gvk) {
@@ -97,6 +137,154 @@ func (a *AccessControlClientset) PodsMetricses(ctx context.Context, namespace, n
 	return convertedMetrics, metricsv1beta1api.Convert_v1beta1_PodMetricsList_To_metrics_PodMetricsList(versionedMetrics, convertedMetrics, nil)
 }
 
+func (a *AccessControlClientset) NodeMetricses(ctx context.Context, name string, listOptions metav1.ListOptions) (*metrics.NodeMetricsList, error) {
+	gvk := &schema.GroupVersionKind{Group: metrics.GroupName, Version: metricsv1beta1api.SchemeGroupVersion.Version, Kind: "NodeMetrics"}
+	if !isAllowed(a.staticConfig, gvk) {
+		return nil, isNotAllowedError(gvk)
+	}
+	versionedMetrics := &metricsv1beta1api.NodeMetricsList{}
+	var err error
+	if name != "" {
+		m, err := a.metricsV1beta1.NodeMetricses().Get(ctx, name, metav1.GetOptions{})
+		if err != nil {
+			return nil, fmt.Errorf("failed to get metrics for node %s: %w", name, err)
+		}
+		versionedMetrics.Items = []metricsv1beta1api.NodeMetrics{*m}
+	} else {
+		versionedMetrics, err = a.metricsV1beta1.NodeMetricses().List(ctx, listOptions)
+		if err != nil {
+			return nil, fmt.Errorf("failed to list node metrics: %w", err)
+		}
+	}
+	convertedMetrics := &metrics.NodeMetricsList{}
+	return convertedMetrics, metricsv1beta1api.Convert_v1beta1_NodeMetricsList_To_metrics_NodeMetricsList(versionedMetrics, convertedMetrics, nil)
+}
+
+func (a *AccessControlClientset) Deployments(namespace string) (appsv1.DeploymentInterface, error) {
+	gvk := &schema.GroupVersionKind{Group: "apps", Version: "v1", Kind: "Deployment"}
+	if !isAllowed(a.staticConfig, gvk) {
+		return nil, isNotAllowedError(gvk)
+	}
+	return a.delegate.AppsV1().Deployments(namespace), nil
+}
+
+func (a *AccessControlClientset) ReplicaSets(namespace string) (appsv1.ReplicaSetInterface, error) {
+	gvk := &schema.GroupVersionKind{Group: "apps", Version: "v1", Kind: "ReplicaSet"}
+	if !isAllowed(a.staticConfig, gvk) {
+		return nil, isNotAllowedError(gvk)
+	}
+	return a.delegate.AppsV1().ReplicaSets(namespace), nil
+}
+
+func (a *AccessControlClientset) StatefulSets(namespace string) (appsv1.StatefulSetInterface, error) {
+	gvk := &schema.GroupVersionKind{Group: "apps", Version: "v1", Kind: "StatefulSet"}
+	if !isAllowed(a.staticConfig, gvk) {
+		return nil, isNotAllowedError(gvk)
+	}
+	return a.delegate.AppsV1().StatefulSets(namespace), nil
+}
+
+func (a *AccessControlClientset) DaemonSets(namespace string) (appsv1.DaemonSetInterface, error) {
+	gvk := &schema.GroupVersionKind{Group: "apps", Version: "v1", Kind: "DaemonSet"}
+	if !isAllowed(a.staticConfig, gvk) {
+		return nil, isNotAllowedError(gvk)
+	}
+	return a.delegate.AppsV1().DaemonSets(namespace), nil
+}
+
+func (a *AccessControlClientset) ControllerRevisions(namespace string) (appsv1.ControllerRevisionInterface, error) {
+	gvk := &schema.GroupVersionKind{Group: "apps", Version: "v1", Kind: "ControllerRevision"}
+	if !isAllowed(a.staticConfig, gvk) {
+		return nil, isNotAllowedError(gvk)
+	}
+	return a.delegate.AppsV1().ControllerRevisions(namespace), nil
+}
+
+func (a *AccessControlClientset) Nodes() (corev1.NodeInterface, error) {
+	gvk := &schema.GroupVersionKind{Group: "", Version: "v1", Kind: "Node"}
+	if !isAllowed(a.staticConfig, gvk) {
+		return nil, isNotAllowedError(gvk)
+	}
+	return a.delegate.CoreV1().Nodes(), nil
+}
+
+// Drain returns the clientset for the kubectl drain helper, limited to the clients it uses to evict the Pods of a Node:
+// discovery (eviction support), Pods and their evictions (any namespace), and DaemonSets (DaemonSet-managed Pods)
+// Access to both Pods and DaemonSets is checked
+func (a *AccessControlClientset) Drain() (kubernetes.Interface, error) {
+	for _, gvk := range []*schema.GroupVersionKind{
+		{Group: "", Version: "v1", Kind: "Pod"},
+		{Group: "apps", Version: "v1", Kind: "DaemonSet"},
+	} {
+		if !isAllowed(a.staticConfig, gvk) {
+			return nil, isNotAllowedError(gvk)
+		}
+	}
+	return &drainClientset{delegate: a.delegate}, nil
+}
+
+// drainClientset only implements the clients used by the kubectl drain helper, any other client is nil
+type drainClientset struct {
+	kubernetes.Interface
+	delegate kubernetes.Interface
+}
+
+func (d *drainClientset) Discovery() discovery.DiscoveryInterface {
+	return d.delegate.Discovery()
+}
+
+func (d *drainClientset) CoreV1() corev1.CoreV1Interface {
+	return &drainCoreV1{delegate: d.delegate.CoreV1()}
+}
+
+func (d *drainClientset) AppsV1() appsv1.AppsV1Interface {
+	return &drainAppsV1{delegate: d.delegate.AppsV1()}
+}
+
+func (d *drainClientset) PolicyV1() policyv1.PolicyV1Interface {
+	return &drainPolicyV1{delegate: d.delegate.PolicyV1()}
+}
+
+func (d *drainClientset) PolicyV1beta1() policyv1beta1.PolicyV1beta1Interface {
+	return &drainPolicyV1beta1{delegate: d.delegate.PolicyV1beta1()}
+}
+
+type drainCoreV1 struct {
+	corev1.CoreV1Interface
+	delegate corev1.CoreV1Interface
+}
+
+func (d *drainCoreV1) Pods(namespace string) corev1.PodInterface {
+	return d.delegate.Pods(namespace)
+}
+
+type drainAppsV1 struct {
+	appsv1.AppsV1Interface
+	delegate appsv1.AppsV1Interface
+}
+
+func (d *drainAppsV1) DaemonSets(namespace string) appsv1.DaemonSetInterface {
+	return d.delegate.DaemonSets(namespace)
+}
+
+type drainPolicyV1 struct {
+	policyv1.PolicyV1Interface
+	delegate policyv1.PolicyV1Interface
+}
+
+func (d *drainPolicyV1) Evictions(namespace string) policyv1.EvictionInterface {
+	return d.delegate.Evictions(namespace)
+}
+
+type drainPolicyV1beta1 struct {
+	policyv1beta1.PolicyV1beta1Interface
+	delegate policyv1beta1.PolicyV1beta1Interface
+}
+
+func (d *drainPolicyV1beta1) Evictions(namespace string) policyv1beta1.EvictionInterface {
+	return d.delegate.Evictions(namespace)
+}
+
 func (a *AccessControlClientset) Services(namespace string) (corev1.ServiceInterface, error) {
 	gvk := &schema.GroupVersionKind{Group: "", Version: "v1", Kind: "Service"}
 	if !isAllowed(a.staticConfig, gvk) {
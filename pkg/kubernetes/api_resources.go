@@ -0,0 +1,78 @@
+package kubernetes
+
+import (
+	"context"
+	"slices"
+	"sort"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/discovery"
+)
+
+type APIResourcesOptions struct {
+	// APIGroup to filter the resources by, core for the legacy core group (all groups if empty)
+	APIGroup string
+	// Verbs that the resources must support (e.g. list, watch)
+	Verbs []string
+}
+
+type APIResource struct {
+	APIVersion string   `json:"apiVersion"`
+	Kind       string   `json:"kind"`
+	Name       string   `json:"name"`
+	ShortNames []string `json:"shortNames,omitempty"`
+	Namespaced bool     `json:"namespaced"`
+	Verbs      []string `json:"verbs"`
+}
+
+// APIResources lists the resources (preferred version of each group) supported by the cluster, hiding the denied resources
+// https://github.com/kubernetes/kubectl/blob/5366de04e168bcbc11f5e340d131a9ca8b7d0df4/pkg/cmd/apiresources/apiresources.go
+func (k *Kubernetes) APIResources(_ context.Context, options APIResourcesOptions) ([]APIResource, error) {
+	lists, err := k.manager.discoveryClient.ServerPreferredResources()
+	// Groups that failed the discovery (e.g. unavailable aggregated APIs) are ignored, the rest of the resources are still returned
+	if err != nil && !discovery.IsGroupDiscoveryFailedError(err) {
+		return nil, err
+	}
+	apiGroup := strings.ToLower(options.APIGroup)
+	if apiGroup == "core" {
+		apiGroup = ""
+	}
+	ret := make([]APIResource, 0)
+	for _, list := range lists {
+		gv, gvErr := schema.ParseGroupVersion(list.GroupVersion)
+		if gvErr != nil || (options.APIGroup != "" && gv.Group != apiGroup) {
+			continue
+		}
+		for _, r := range list.APIResources {
+			// Subresources (e.g. pods/log, deployments/scale) are skipped
+			if strings.Contains(r.Name, "/") {
+				continue
+			}
+			if !isAllowed(k.manager.staticConfig, &schema.GroupVersionKind{Group: gv.Group, Version: gv.Version, Kind: r.Kind}) {
+				continue
+			}
+			if slices.ContainsFunc(options.Verbs, func(verb string) bool { return !slices.Contains(r.Verbs, verb) }) {
+				continue
+			}
+			ret = append(ret, APIResource{
+				APIVersion: gv.String(),
+				Kind:       r.Kind,
+				Name:       r.Name,
+				ShortNames: r.ShortNames,
+				Namespaced: r.Namespaced,
+				Verbs:      r.Verbs,
+			})
+		}
+	}
+	// Sorted by group (legacy core group first) and name
+	sort.SliceStable(ret, func(i, j int) bool {
+		gvi, _ := schema.ParseGroupVersion(ret[i].APIVersion)
+		gvj, _ := schema.ParseGroupVersion(ret[j].APIVersion)
+		if gvi.Group != gvj.Group {
+			return gvi.Group < gvj.Group
+		}
+		return ret[i].Name < ret[j].Name
+	})
+	return ret, nil
+}
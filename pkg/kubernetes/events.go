@@ -10,10 +10,15 @@ import (
 )
 
 func (k *Kubernetes) EventsList(ctx context.Context, namespace string) ([]map[string]any, error) {
+	return k.eventsList(ctx, namespace, ResourceListOptions{})
+}
+
+// eventsList lists the events matching the provided options (e.g. involvedObject.name field selector)
+func (k *Kubernetes) eventsList(ctx context.Context, namespace string, options ResourceListOptions) ([]map[string]any, error) {
 	var eventMap []map[string]any
 	raw, err := k.ResourcesList(ctx, &schema.GroupVersionKind{
 		Group: "", Version: "v1", Kind: "Event",
-	}, namespace, ResourceListOptions{})
+	}, namespace, options)
 	if err != nil {
 		return eventMap, err
 	}
@@ -0,0 +1,170 @@
+package kubernetes
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	batchv1 "k8s.io/api/batch/v1"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/rand"
+)
+
+var (
+	jobGvk     = workloadKinds["job"]
+	cronJobGvk = &schema.GroupVersionKind{Group: "batch", Version: "v1", Kind: "CronJob"}
+)
+
+type JobStatusSummary struct {
+	Name      string `json:"name"`
+	Namespace string `json:"namespace"`
+	// Status of the Job (Complete, Failed, Suspended, Running, or Pending)
+	Status         string             `json:"status"`
+	Completions    *int32             `json:"completions,omitempty"`
+	Parallelism    *int32             `json:"parallelism,omitempty"`
+	Active         int32              `json:"active"`
+	Succeeded      int32              `json:"succeeded"`
+	Failed         int32              `json:"failed"`
+	StartTime      *metav1.Time       `json:"startTime,omitempty"`
+	CompletionTime *metav1.Time       `json:"completionTime,omitempty"`
+	Conditions     []ConditionSummary `json:"conditions,omitempty"`
+	FailureReasons []string           `json:"failureReasons,omitempty"`
+	// Pods of the latest attempt (the most recently created Pods, up to the Job parallelism)
+	Pods []PodStatusSummary `json:"pods,omitempty"`
+}
+
+// JobsCreateFromCronJob creates a Job from the jobTemplate of the provided CronJob (same as kubectl create job --from=cronjob/name)
+// https://github.com/kubernetes/kubectl/blob/5366de04e168bcbc11f5e340d131a9ca8b7d0df4/pkg/cmd/create/create_job.go#L254-L286
+func (k *Kubernetes) JobsCreateFromCronJob(ctx context.Context, namespace, cronJobName, name string) (*unstructured.Unstructured, error) {
+	cronJob, err := k.ResourcesGet(ctx, cronJobGvk, namespace, cronJobName)
+	if err != nil {
+		return nil, err
+	}
+	jobTemplate, found, err := unstructured.NestedMap(cronJob.Object, "spec", "jobTemplate")
+	if err != nil || !found {
+		return nil, fmt.Errorf("CronJob %s has no jobTemplate", cronJobName)
+	}
+	if name == "" {
+		name = cronJobName + "-manual-" + rand.String(5)
+	}
+	job := &unstructured.Unstructured{}
+	job.SetGroupVersionKind(*jobGvk)
+	job.SetName(name)
+	job.SetNamespace(cronJob.GetNamespace())
+	labels, _, _ := unstructured.NestedStringMap(jobTemplate, "metadata", "labels")
+	job.SetLabels(labels)
+	annotations, _, _ := unstructured.NestedStringMap(jobTemplate, "metadata", "annotations")
+	if annotations == nil {
+		annotations = map[string]string{}
+	}
+	annotations["cronjob.kubernetes.io/instantiate"] = "manual"
+	job.SetAnnotations(annotations)
+	job.SetOwnerReferences([]metav1.OwnerReference{*metav1.NewControllerRef(cronJob, cronJobGvk.GroupVersion().WithKind(cronJobGvk.Kind))})
+	if spec, ok := jobTemplate["spec"]; ok {
+		job.Object["spec"] = spec
+	}
+	jobs, err := k.resourcesCreateOrUpdate(ctx, []*unstructured.Unstructured{job})
+	if err != nil {
+		return nil, err
+	}
+	return jobs[0], nil
+}
+
+// CronJobsSuspend suspends (suspend true) or resumes (suspend false) the scheduling of the provided CronJob
+func (k *Kubernetes) CronJobsSuspend(ctx context.Context, namespace, name string, suspend bool) (*unstructured.Unstructured, error) {
+	// Retrieve the CronJob first, the apply would otherwise try to create a new (invalid) CronJob
+	cronJob, err := k.ResourcesGet(ctx, cronJobGvk, namespace, name)
+	if err != nil {
+		return nil, err
+	}
+	patch := &unstructured.Unstructured{Object: map[string]interface{}{"spec": map[string]interface{}{"suspend": suspend}}}
+	patch.SetGroupVersionKind(*cronJobGvk)
+	patch.SetName(cronJob.GetName())
+	patch.SetNamespace(cronJob.GetNamespace())
+	cronJobs, err := k.resourcesCreateOrUpdate(ctx, []*unstructured.Unstructured{patch})
+	if err != nil {
+		return nil, err
+	}
+	return cronJobs[0], nil
+}
+
+// JobsStatus summarizes the status of the provided Job and the Pods of its latest attempt
+func (k *Kubernetes) JobsStatus(ctx context.Context, namespace, name string) (*JobStatusSummary, error) {
+	u, err := k.ResourcesGet(ctx, jobGvk, namespace, name)
+	if err != nil {
+		return nil, err
+	}
+	job := &batchv1.Job{}
+	if err = runtime.DefaultUnstructuredConverter.FromUnstructured(u.Object, job); err != nil {
+		return nil, err
+	}
+	summary := &JobStatusSummary{
+		Name:           job.Name,
+		Namespace:      job.Namespace,
+		Completions:    job.Spec.Completions,
+		Parallelism:    job.Spec.Parallelism,
+		Active:         job.Status.Active,
+		Succeeded:      job.Status.Succeeded,
+		Failed:         job.Status.Failed,
+		StartTime:      job.Status.StartTime,
+		CompletionTime: job.Status.CompletionTime,
+	}
+	for _, condition := range job.Status.Conditions {
+		summary.Conditions = append(summary.Conditions, ConditionSummary{
+			Type: string(condition.Type), Status: string(condition.Status), Reason: condition.Reason, Message: condition.Message,
+		})
+		if condition.Status != v1.ConditionTrue {
+			continue
+		}
+		switch condition.Type {
+		case batchv1.JobComplete, batchv1.JobFailed, batchv1.JobSuspended:
+			summary.Status = string(condition.Type)
+			if condition.Type == batchv1.JobFailed {
+				summary.FailureReasons = append(summary.FailureReasons, fmt.Sprintf("job failed: %s: %s", condition.Reason, condition.Message))
+			}
+		}
+	}
+	if summary.Status == "" && job.Status.Active > 0 {
+		summary.Status = "Running"
+	} else if summary.Status == "" {
+		summary.Status = "Pending"
+	}
+	if job.Spec.Selector == nil {
+		return summary, nil
+	}
+	pods, err := k.manager.accessControlClientSet.Pods(job.Namespace)
+	if err != nil {
+		return nil, err
+	}
+	podList, err := pods.List(ctx, metav1.ListOptions{LabelSelector: metav1.FormatLabelSelector(job.Spec.Selector)})
+	if err != nil {
+		return nil, err
+	}
+	sort.SliceStable(podList.Items, func(i, j int) bool {
+		return podList.Items[j].CreationTimestamp.Before(&podList.Items[i].CreationTimestamp)
+	})
+	latest := 1
+	if job.Spec.Parallelism != nil && int(*job.Spec.Parallelism) > latest {
+		latest = int(*job.Spec.Parallelism)
+	}
+	for i := range podList.Items {
+		if i >= latest {
+			break
+		}
+		pod := &podList.Items[i]
+		summary.Pods = append(summary.Pods, *summarizePodStatus(pod))
+		if pod.Status.Phase == v1.PodFailed && pod.Status.Reason != "" {
+			summary.FailureReasons = append(summary.FailureReasons, fmt.Sprintf("pod %s failed: %s: %s", pod.Name, pod.Status.Reason, pod.Status.Message))
+		}
+		for _, cs := range pod.Status.ContainerStatuses {
+			if t := cs.State.Terminated; t != nil && t.ExitCode != 0 {
+				summary.FailureReasons = append(summary.FailureReasons, fmt.Sprintf("pod %s container %s terminated with reason %s (exit code %d)", pod.Name, cs.Name, t.Reason, t.ExitCode))
+			}
+		}
+	}
+	return summary, nil
+}
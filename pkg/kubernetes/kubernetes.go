@@ -14,6 +14,7 @@ import (
 	"k8s.io/client-go/discovery/cached/memory"
 	"k8s.io/client-go/dynamic"
 	"k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/client-go/metadata"
 	"k8s.io/client-go/rest"
 	"k8s.io/client-go/restmapper"
 	"k8s.io/client-go/tools/clientcmd"
@@ -48,6 +49,7 @@ type Manager struct {
 	accessControlClientSet  *AccessControlClientset
 	accessControlRESTMapper *AccessControlRESTMapper
 	dynamicClient           *dynamic.DynamicClient
+	metadataClient          metadata.Interface
 
 	staticConfig         *config.StaticConfig
 	CloseWatchKubeConfig CloseWatchKubeConfig
@@ -83,6 +85,10 @@ func NewManager(config *config.StaticConfig) (*Manager, error) {
 	if err != nil {
 		return nil, err
 	}
+	k8s.metadataClient, err = metadata.NewForConfig(k8s.cfg)
+	if err != nil {
+		return nil, err
+	}
 	return k8s, nil
 }
 
@@ -205,6 +211,14 @@ func (m *Manager) Derived(ctx context.Context) (*Kubernetes, error) {
 		}
 		return &Kubernetes{manager: m}, nil
 	}
+	derived.manager.metadataClient, err = metadata.NewForConfig(derived.manager.cfg)
+	if err != nil {
+		if m.staticConfig.RequireOAuth {
+			klog.Errorf("failed to initialize metadata client: %v", err)
+			return nil, errors.New("failed to initialize metadata client")
+		}
+		return &Kubernetes{manager: m}, nil
+	}
 	return derived, nil
 }
 
@@ -0,0 +1,273 @@
+package kubernetes
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"slices"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	utilerrors "k8s.io/apimachinery/pkg/util/errors"
+	corev1 "k8s.io/client-go/kubernetes/typed/core/v1"
+	"k8s.io/kubectl/pkg/drain"
+	"k8s.io/kubectl/pkg/metricsutil"
+	"k8s.io/metrics/pkg/apis/metrics"
+	metricsv1beta1api "k8s.io/metrics/pkg/apis/metrics/v1beta1"
+)
+
+type NodesTopOptions struct {
+	metav1.ListOptions
+	Name string
+	// SortBy cpu or memory (descending), sorted by name if not provided
+	SortBy string
+	// Limit the number of returned Nodes after sorting (all Nodes if 0)
+	Limit int
+}
+
+// NodesTop returns the resource consumption of the Nodes and their allocatable resources (used to compute the usage percentage)
+func (k *Kubernetes) NodesTop(ctx context.Context, options NodesTopOptions) (*metrics.NodeMetricsList, map[string]v1.ResourceList, error) {
+	if !k.supportsGroupVersion(metrics.GroupName + "/" + metricsv1beta1api.SchemeGroupVersion.Version) {
+		return nil, nil, errors.New("metrics API is not available")
+	}
+	ret, err := k.manager.accessControlClientSet.NodeMetricses(ctx, options.Name, options.ListOptions)
+	if err != nil {
+		return nil, nil, err
+	}
+	sort.Sort(metricsutil.NewNodeMetricsSorter(ret.Items, options.SortBy))
+	if options.Limit > 0 && len(ret.Items) > options.Limit {
+		ret.Items = ret.Items[:options.Limit]
+	}
+	gvk := &schema.GroupVersionKind{Group: "", Version: "v1", Kind: "Node"}
+	var nodes []unstructured.Unstructured
+	if options.Name != "" {
+		node, err := k.ResourcesGet(ctx, gvk, "", options.Name)
+		if err != nil {
+			return nil, nil, err
+		}
+		nodes = append(nodes, *node)
+	} else {
+		list, err := k.ResourcesList(ctx, gvk, "", ResourceListOptions{ListOptions: metav1.ListOptions{LabelSelector: options.LabelSelector}})
+		if err != nil {
+			return nil, nil, err
+		}
+		nodes = list.(*unstructured.UnstructuredList).Items
+	}
+	allocatable := make(map[string]v1.ResourceList, len(ret.Items))
+	for _, item := range nodes {
+		node := &v1.Node{}
+		if err = runtime.DefaultUnstructuredConverter.FromUnstructured(item.Object, node); err != nil {
+			return nil, nil, err
+		}
+		// Nodes without metrics are reported as missing, unless they were excluded by the limit
+		if options.Limit > 0 && !slices.ContainsFunc(ret.Items, func(m metrics.NodeMetrics) bool { return m.Name == node.Name }) {
+			continue
+		}
+		allocatable[node.Name] = node.Status.Allocatable
+	}
+	return ret, allocatable, nil
+}
+
+type NodesDrainOptions struct {
+	// IgnoreDaemonSets ignores DaemonSet-managed Pods (they would be recreated on the Node anyway)
+	IgnoreDaemonSets bool
+	// DeleteEmptyDirData evicts Pods using emptyDir volumes (their data is lost)
+	DeleteEmptyDirData bool
+	// Force evicts Pods that are not managed by a controller (they won't be recreated)
+	Force bool
+	// GracePeriodSeconds for the evicted Pods to terminate (negative to use the Pod's terminationGracePeriodSeconds)
+	GracePeriodSeconds int
+	// Timeout to wait for the evictions to complete
+	Timeout time.Duration
+	// DryRun only reports the Pods that would be evicted, the Node is not cordoned
+	DryRun bool
+}
+
+type NodesDrainResult struct {
+	Node string `json:"node"`
+	// Cordoned is true if the Node was cordoned by this drain (false if it was already cordoned)
+	Cordoned bool `json:"cordoned"`
+	DryRun   bool `json:"dryRun,omitempty"`
+	// Pods to evict from the Node
+	Pods     []string               `json:"pods,omitempty"`
+	Evicted  []string               `json:"evicted,omitempty"`
+	Blocked  []NodesDrainBlockedPod `json:"blocked,omitempty"`
+	Warnings string                 `json:"warnings,omitempty"`
+	// Message describes the state of the Node after a drain that didn't complete
+	Message string `json:"message,omitempty"`
+	// Errors preventing the drain from starting (e.g. Pods that can't be evicted without ignoreDaemonSets, deleteEmptyDirData, or force)
+	Errors []string `json:"errors,omitempty"`
+}
+
+type NodesDrainBlockedPod struct {
+	Pod    string `json:"pod"`
+	Reason string `json:"reason"`
+}
+
+// NodesCordon marks the Node as unschedulable (desired true) or schedulable (desired false)
+// and returns whether the Node was updated
+func (k *Kubernetes) NodesCordon(ctx context.Context, name string, desired bool) (bool, error) {
+	nodes, err := k.manager.accessControlClientSet.Nodes()
+	if err != nil {
+		return false, err
+	}
+	return nodesCordon(ctx, nodes, name, desired)
+}
+
+// NodesDrain cordons the Node and evicts its Pods honoring their PodDisruptionBudgets
+// https://github.com/kubernetes/kubectl/blob/5366de04e168bcbc11f5e340d131a9ca8b7d0df4/pkg/cmd/drain/drain.go#L304-L340
+func (k *Kubernetes) NodesDrain(ctx context.Context, name string, options NodesDrainOptions) (*NodesDrainResult, error) {
+	nodes, err := k.manager.accessControlClientSet.Nodes()
+	if err != nil {
+		return nil, err
+	}
+	clientset, err := k.manager.accessControlClientSet.Drain()
+	if err != nil {
+		return nil, err
+	}
+	result := &NodesDrainResult{Node: name, DryRun: options.DryRun}
+	errOut := &drainErrOut{}
+	var mu sync.Mutex
+	helper := &drain.Helper{
+		Ctx:                 ctx,
+		Client:              clientset,
+		Force:               options.Force,
+		GracePeriodSeconds:  options.GracePeriodSeconds,
+		IgnoreAllDaemonSets: options.IgnoreDaemonSets,
+		DeleteEmptyDirData:  options.DeleteEmptyDirData,
+		Timeout:             options.Timeout,
+		Out:                 io.Discard,
+		ErrOut:              errOut,
+		OnPodDeletionOrEvictionFinished: func(pod *v1.Pod, _ bool, err error) {
+			if err == nil {
+				mu.Lock()
+				defer mu.Unlock()
+				result.Evicted = append(result.Evicted, pod.Namespace+"/"+pod.Name)
+			}
+		},
+	}
+	if !options.DryRun {
+		if result.Cordoned, err = nodesCordon(ctx, nodes, name, true); err != nil {
+			return nil, err
+		}
+	} else if _, err = nodes.Get(ctx, name, metav1.GetOptions{}); err != nil {
+		return nil, err
+	}
+	// The Node is uncordoned if the drain fails before evicting any Pod (only if it was cordoned by this drain)
+	uncordon := func() error {
+		if !result.Cordoned {
+			return nil
+		}
+		if _, uncordonErr := nodesCordon(ctx, nodes, name, false); uncordonErr != nil {
+			return fmt.Errorf("failed to uncordon node: %w", uncordonErr)
+		}
+		result.Cordoned = false
+		return nil
+	}
+	list, errs := helper.GetPodsForDeletion(name)
+	if list == nil {
+		return nil, utilerrors.NewAggregate(append(errs, uncordon()))
+	}
+	for _, pod := range list.Pods() {
+		result.Pods = append(result.Pods, pod.Namespace+"/"+pod.Name)
+	}
+	result.Warnings = list.Warnings()
+	for _, e := range errs {
+		result.Errors = append(result.Errors, e.Error())
+	}
+	if options.DryRun {
+		return result, nil
+	}
+	if len(errs) > 0 {
+		if err = uncordon(); err != nil {
+			result.Errors = append(result.Errors, err.Error())
+		}
+		return result, nil
+	}
+	// Evictions blocked by PodDisruptionBudgets are retried until the timeout
+	drainErr := helper.DeleteOrEvictPods(list.Pods())
+	var drainErrs []error
+	if agg, ok := drainErr.(utilerrors.Aggregate); ok {
+		drainErrs = agg.Errors()
+	} else if drainErr != nil {
+		drainErrs = []error{drainErr}
+	}
+	slices.Sort(result.Evicted)
+	for _, pod := range list.Pods() {
+		if slices.Contains(result.Evicted, pod.Namespace+"/"+pod.Name) {
+			continue
+		}
+		result.Blocked = append(result.Blocked, NodesDrainBlockedPod{
+			Pod:    pod.Namespace + "/" + pod.Name,
+			Reason: errOut.reasonFor(&pod, drainErrs),
+		})
+	}
+	// Some Pods were already evicted, the Node is kept cordoned so that they're not rescheduled on it
+	if len(result.Blocked) > 0 {
+		result.Message = "The Node remains cordoned (unschedulable), drain it again or uncordon it with nodes_uncordon"
+	}
+	return result, nil
+}
+
+func nodesCordon(ctx context.Context, nodes corev1.NodeInterface, name string, desired bool) (bool, error) {
+	node, err := nodes.Get(ctx, name, metav1.GetOptions{})
+	if err != nil {
+		return false, err
+	}
+	cordonHelper := drain.NewCordonHelper(node)
+	if !cordonHelper.UpdateIfRequired(desired) {
+		return false, nil
+	}
+	// https://github.com/kubernetes/kubectl/blob/5366de04e168bcbc11f5e340d131a9ca8b7d0df4/pkg/drain/cordon.go#L81-L111
+	patch := fmt.Sprintf(`{"spec":{"unschedulable":%t}}`, desired)
+	if _, err = nodes.Patch(ctx, name, types.StrategicMergePatchType, []byte(patch), metav1.PatchOptions{}); err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+// drainErrOut collects the error messages reported by the kubectl drain helper (concurrently for each Pod)
+type drainErrOut struct {
+	mu    sync.Mutex
+	lines []string
+}
+
+func (d *drainErrOut) Write(p []byte) (int, error) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	d.lines = append(d.lines, strings.Split(strings.TrimSpace(string(p)), "\n")...)
+	return len(p), nil
+}
+
+// reasonFor returns the last error message reported for the Pod (e.g. the eviction blocked by a PodDisruptionBudget)
+func (d *drainErrOut) reasonFor(pod *v1.Pod, drainErrs []error) string {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	references := []string{
+		fmt.Sprintf("pods/%q -n %q", pod.Name, pod.Namespace),
+		fmt.Sprintf("pod %q in namespace %q", pod.Name, pod.Namespace),
+		fmt.Sprintf("pod %q from terminating namespace %q", pod.Name, pod.Namespace),
+	}
+	reason := "eviction did not complete"
+	for _, e := range drainErrs {
+		if slices.ContainsFunc(references, func(r string) bool { return strings.Contains(e.Error(), r) }) {
+			reason = e.Error()
+		}
+	}
+	// Retried errors (e.g. PodDisruptionBudget violations) are more descriptive than the final timeout error
+	for _, line := range d.lines {
+		if slices.ContainsFunc(references, func(r string) bool { return strings.Contains(line, r) }) {
+			reason = line
+		}
+	}
+	return reason
+}
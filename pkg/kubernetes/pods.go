@@ -1,31 +1,122 @@
 package kubernetes
 
 import (
+	"archive/tar"
 	"bytes"
 	"context"
+	"encoding/base64"
 	"errors"
 	"fmt"
+	"io"
+	"maps"
+	"path"
+	"slices"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+	"unicode/utf8"
+
+	"golang.org/x/sync/errgroup"
 
 	v1 "k8s.io/api/core/v1"
+	policyv1 "k8s.io/api/policy/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/fields"
 	labelutil "k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/apimachinery/pkg/util/intstr"
 	"k8s.io/apimachinery/pkg/util/rand"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/apimachinery/pkg/watch"
+	corev1 "k8s.io/client-go/kubernetes/typed/core/v1"
 	"k8s.io/client-go/tools/remotecommand"
+	toolswatch "k8s.io/client-go/tools/watch"
+	utilexec "k8s.io/client-go/util/exec"
+	"k8s.io/kubectl/pkg/metricsutil"
 	"k8s.io/metrics/pkg/apis/metrics"
 	metricsv1beta1api "k8s.io/metrics/pkg/apis/metrics/v1beta1"
+	"k8s.io/utils/ptr"
 
 	"github.com/manusa/kubernetes-mcp-server/pkg/version"
 )
 
+type PodsLogOptions struct {
+	v1.PodLogOptions
+}
+
+type PodsLogSelectorOptions struct {
+	PodsLogOptions
+	LabelSelector string
+	// WorkloadKind and WorkloadName identify a Deployment, StatefulSet, DaemonSet, ReplicaSet, or Job whose
+	// Pod selector is used to find the Pods (alternative to LabelSelector)
+	WorkloadKind string
+	WorkloadName string
+}
+
+type PodsExecOptions struct {
+	Container string
+	Command   []string
+	// Stdin is streamed to the command's standard input when not nil
+	Stdin   io.Reader
+	Timeout time.Duration
+	// MaxStdoutBytes aborts the command if its standard output exceeds the provided size (no limit if 0)
+	MaxStdoutBytes int64
+}
+
+type PodsExecResult struct {
+	Stdout   string
+	Stderr   string
+	ExitCode int
+}
+
+type PodsRunOptions struct {
+	Name            string
+	Image           string
+	Port            int32
+	Command         []string
+	Args            []string
+	Env             map[string]string
+	Resources       v1.ResourceRequirements
+	ImagePullPolicy v1.PullPolicy
+	RestartPolicy   v1.RestartPolicy
+	Labels          map[string]string
+	ServiceAccount  string
+}
+
+// PodStatusSummary is a compact representation of the status of a Pod and its containers
+type PodStatusSummary struct {
+	Name       string                   `json:"name"`
+	Namespace  string                   `json:"namespace"`
+	Phase      v1.PodPhase              `json:"phase"`
+	Ready      bool                     `json:"ready"`
+	Reason     string                   `json:"reason,omitempty"`
+	Message    string                   `json:"message,omitempty"`
+	Containers []ContainerStatusSummary `json:"containers,omitempty"`
+}
+
+type ContainerStatusSummary struct {
+	Name         string `json:"name"`
+	Ready        bool   `json:"ready"`
+	RestartCount int32  `json:"restartCount"`
+	State        string `json:"state"`
+	Reason       string `json:"reason,omitempty"`
+	Message      string `json:"message,omitempty"`
+	ExitCode     *int32 `json:"exitCode,omitempty"`
+}
+
 type PodsTopOptions struct {
 	metav1.ListOptions
 	AllNamespaces bool
 	Namespace     string
 	Name          string
+	// SortBy cpu or memory (descending), sorted by namespace and name if not provided
+	SortBy string
+	// Limit the number of returned Pods after sorting (all Pods if 0)
+	Limit int
 }
 
 func (k *Kubernetes) PodsListInAllNamespaces(ctx context.Context, options ResourceListOptions) (runtime.Unstructured, error) {
@@ -89,19 +180,72 @@ func (k *Kubernetes) PodsDelete(ctx context.Context, namespace, name string) (st
 
 	}
 	return "Pod deleted successfully",
-		k.ResourcesDelete(ctx, &schema.GroupVersionKind{Group: "", Version: "v1", Kind: "Pod"}, namespace, name)
+		k.ResourcesDelete(ctx, &schema.GroupVersionKind{Group: "", Version: "v1", Kind: "Pod"}, namespace, name, ResourcesDeleteOptions{})
 }
 
-func (k *Kubernetes) PodsLog(ctx context.Context, namespace, name, container string) (string, error) {
-	tailLines := int64(256)
-	pods, err := k.manager.accessControlClientSet.Pods(k.NamespaceOrDefault(namespace))
+// PodsEvict evicts the Pod through the Eviction subresource so that PodDisruptionBudgets are honored
+func (k *Kubernetes) PodsEvict(ctx context.Context, namespace, name string, gracePeriodSeconds *int64) (string, error) {
+	namespace = k.NamespaceOrDefault(namespace)
+	pods, err := k.manager.accessControlClientSet.Pods(namespace)
 	if err != nil {
 		return "", err
 	}
-	req := pods.GetLogs(name, &v1.PodLogOptions{
-		TailLines: &tailLines,
-		Container: container,
+	pod, err := pods.Get(ctx, name, metav1.GetOptions{})
+	if err != nil {
+		return "", err
+	}
+	err = pods.EvictV1(ctx, &policyv1.Eviction{
+		ObjectMeta:    metav1.ObjectMeta{Namespace: namespace, Name: name},
+		DeleteOptions: &metav1.DeleteOptions{GracePeriodSeconds: gracePeriodSeconds},
 	})
+	// The API server returns 429 TooManyRequests when the eviction would violate a PodDisruptionBudget
+	// https://kubernetes.io/docs/concepts/scheduling-eviction/api-eviction/#how-api-initiated-eviction-works
+	if apierrors.IsTooManyRequests(err) {
+		return "", k.podsEvictBlockedError(ctx, pod, err)
+	}
+	if err != nil {
+		return "", err
+	}
+	return "Pod evicted successfully", nil
+}
+
+// podsEvictBlockedError describes the PodDisruptionBudgets matching the Pod that prevent its eviction
+func (k *Kubernetes) podsEvictBlockedError(ctx context.Context, pod *v1.Pod, evictErr error) error {
+	list, err := k.ResourcesList(ctx, &schema.GroupVersionKind{Group: "policy", Version: "v1", Kind: "PodDisruptionBudget"}, pod.Namespace, ResourceListOptions{})
+	if err != nil {
+		return fmt.Errorf("eviction blocked: %v (unable to list PodDisruptionBudgets: %v)", evictErr, err)
+	}
+	var blocking []string
+	for _, item := range list.(*unstructured.UnstructuredList).Items {
+		pdb := &policyv1.PodDisruptionBudget{}
+		// PodDisruptionBudgets that can't be converted are skipped, the eviction error is still reported
+		if err = runtime.DefaultUnstructuredConverter.FromUnstructured(item.Object, pdb); err != nil {
+			continue
+		}
+		// An empty selector matches every Pod in the namespace, a missing selector matches none (labels.Nothing)
+		selector, err := metav1.LabelSelectorAsSelector(pdb.Spec.Selector)
+		if err != nil || !selector.Matches(labelutil.Set(pod.Labels)) {
+			continue
+		}
+		blocking = append(blocking, fmt.Sprintf("PodDisruptionBudget %s allows %d disruptions (current healthy: %d, desired healthy: %d, expected pods: %d)",
+			pdb.Name, pdb.Status.DisruptionsAllowed, pdb.Status.CurrentHealthy, pdb.Status.DesiredHealthy, pdb.Status.ExpectedPods))
+	}
+	if len(blocking) == 0 {
+		return fmt.Errorf("eviction blocked: %v", evictErr)
+	}
+	return fmt.Errorf("eviction blocked: %v\n%s", evictErr, strings.Join(blocking, "\n"))
+}
+
+func (k *Kubernetes) PodsLog(ctx context.Context, namespace, name string, options PodsLogOptions) (string, error) {
+	// Default to the last 256 lines unless a specific time window or size limit was requested
+	if options.TailLines == nil && options.SinceSeconds == nil && options.SinceTime == nil && options.LimitBytes == nil {
+		options.TailLines = ptr.To(int64(256))
+	}
+	pods, err := k.manager.accessControlClientSet.Pods(k.NamespaceOrDefault(namespace))
+	if err != nil {
+		return "", err
+	}
+	req := pods.GetLogs(name, &options.PodLogOptions)
 	res := req.Do(ctx)
 	if res.Error() != nil {
 		return "", res.Error()
@@ -113,7 +257,141 @@ func (k *Kubernetes) PodsLog(ctx context.Context, namespace, name, container str
 	return string(rawData), nil
 }
 
-func (k *Kubernetes) PodsRun(ctx context.Context, namespace, name, image string, port int32) ([]*unstructured.Unstructured, error) {
+// PodsLogSelector retrieves the logs of every container in every Pod matching the provided selector or workload.
+// Logs are fetched concurrently, merged by timestamp, and each line is prefixed with [pod/container] (similar to stern).
+func (k *Kubernetes) PodsLogSelector(ctx context.Context, namespace string, options PodsLogSelectorOptions) (string, error) {
+	namespace = k.NamespaceOrDefault(namespace)
+	labelSelector := options.LabelSelector
+	if options.WorkloadKind != "" || options.WorkloadName != "" {
+		selector, err := k.workloadPodSelector(ctx, namespace, options.WorkloadKind, options.WorkloadName)
+		if err != nil {
+			return "", err
+		}
+		labelSelector = selector
+	}
+	if labelSelector == "" {
+		return "", errors.New("either a label selector or a workload is required")
+	}
+	podList, err := k.PodsListInNamespace(ctx, namespace, ResourceListOptions{ListOptions: metav1.ListOptions{LabelSelector: labelSelector}})
+	if err != nil {
+		return "", err
+	}
+	type podContainer struct{ pod, container string }
+	var targets []podContainer
+	for _, item := range podList.(*unstructured.UnstructuredList).Items {
+		pod := &v1.Pod{}
+		if err = runtime.DefaultUnstructuredConverter.FromUnstructured(item.Object, pod); err != nil {
+			return "", err
+		}
+		for _, c := range pod.Spec.Containers {
+			if options.Container == "" || options.Container == c.Name {
+				targets = append(targets, podContainer{pod: pod.Name, container: c.Name})
+			}
+		}
+	}
+	if len(targets) == 0 {
+		return "", nil
+	}
+	// Timestamps are always requested so that lines from different containers can be merged in order
+	userTimestamps := options.Timestamps
+	options.Timestamps = true
+	results := make([][]logLine, len(targets))
+	tasks, tasksCtx := errgroup.WithContext(ctx)
+	tasks.SetLimit(10)
+	for i, target := range targets {
+		tasks.Go(func() error {
+			containerOptions := options.PodsLogOptions
+			containerOptions.Container = target.container
+			prefix := fmt.Sprintf("[%s/%s] ", target.pod, target.container)
+			logs, logErr := k.PodsLog(tasksCtx, namespace, target.pod, containerOptions)
+			if logErr != nil {
+				results[i] = []logLine{{text: prefix + "failed to get logs: " + logErr.Error()}}
+				return nil
+			}
+			results[i] = parseLogLines(prefix, logs, userTimestamps)
+			return nil
+		})
+	}
+	_ = tasks.Wait()
+	var merged []logLine
+	for _, r := range results {
+		merged = append(merged, r...)
+	}
+	sort.SliceStable(merged, func(i, j int) bool { return merged[i].timestamp.Before(merged[j].timestamp) })
+	sb := strings.Builder{}
+	for _, line := range merged {
+		sb.WriteString(line.text)
+		sb.WriteString("\n")
+	}
+	return sb.String(), nil
+}
+
+// workloadPodSelector returns the Pod label selector (as a string) for the provided workload
+func (k *Kubernetes) workloadPodSelector(ctx context.Context, namespace, kind, name string) (string, error) {
+	if name == "" {
+		return "", errors.New("workload name is required")
+	}
+	gvk, ok := workloadKinds[strings.ToLower(kind)]
+	if !ok {
+		return "", fmt.Errorf("unsupported workload kind %q (supported: Deployment, StatefulSet, DaemonSet, ReplicaSet, Job)", kind)
+	}
+	workload, err := k.ResourcesGet(ctx, gvk, namespace, name)
+	if err != nil {
+		return "", err
+	}
+	rawSelector, found, err := unstructured.NestedMap(workload.Object, "spec", "selector")
+	if err != nil || !found {
+		return "", fmt.Errorf("%s %s has no pod selector", gvk.Kind, name)
+	}
+	labelSelector := &metav1.LabelSelector{}
+	if err = runtime.DefaultUnstructuredConverter.FromUnstructured(rawSelector, labelSelector); err != nil {
+		return "", err
+	}
+	selector, err := metav1.LabelSelectorAsSelector(labelSelector)
+	if err != nil {
+		return "", err
+	}
+	return selector.String(), nil
+}
+
+// workloadKinds maps the lowercase kind of the supported workloads to their GroupVersionKind
+var workloadKinds = map[string]*schema.GroupVersionKind{
+	"deployment":  {Group: "apps", Version: "v1", Kind: "Deployment"},
+	"statefulset": {Group: "apps", Version: "v1", Kind: "StatefulSet"},
+	"daemonset":   {Group: "apps", Version: "v1", Kind: "DaemonSet"},
+	"replicaset":  {Group: "apps", Version: "v1", Kind: "ReplicaSet"},
+	"job":         {Group: "batch", Version: "v1", Kind: "Job"},
+}
+
+type logLine struct {
+	timestamp time.Time
+	text      string
+}
+
+// parseLogLines splits timestamped logs into lines, prefixing each of them and optionally removing the timestamp
+func parseLogLines(prefix, logs string, withTimestamps bool) []logLine {
+	var lines []logLine
+	var last time.Time
+	for _, line := range strings.Split(strings.TrimRight(logs, "\n"), "\n") {
+		if line == "" {
+			continue
+		}
+		text := line
+		if ts, rest, found := strings.Cut(line, " "); found {
+			if t, err := time.Parse(time.RFC3339Nano, ts); err == nil {
+				last = t
+				if !withTimestamps {
+					text = rest
+				}
+			}
+		}
+		lines = append(lines, logLine{timestamp: last, text: prefix + text})
+	}
+	return lines
+}
+
+func (k *Kubernetes) PodsRun(ctx context.Context, namespace string, options PodsRunOptions) ([]*unstructured.Unstructured, error) {
+	name := options.Name
 	if name == "" {
 		name = version.BinaryName + "-run-" + rand.String(5)
 	}
@@ -123,17 +401,36 @@ func (k *Kubernetes) PodsRun(ctx context.Context, namespace, name, image string,
 		AppKubernetesManagedBy: version.BinaryName,
 		AppKubernetesPartOf:    version.BinaryName + "-run-sandbox",
 	}
+	// User provided labels can't override the managed labels (used by the Service selector and to clean up)
+	podLabels := make(map[string]string, len(options.Labels)+len(labels))
+	maps.Copy(podLabels, options.Labels)
+	maps.Copy(podLabels, labels)
+	imagePullPolicy := options.ImagePullPolicy
+	if imagePullPolicy == "" {
+		imagePullPolicy = v1.PullAlways
+	}
 	// NewPod
 	var resources []any
 	pod := &v1.Pod{
 		TypeMeta:   metav1.TypeMeta{APIVersion: "v1", Kind: "Pod"},
-		ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: k.NamespaceOrDefault(namespace), Labels: labels},
-		Spec: v1.PodSpec{Containers: []v1.Container{{
-			Name:            name,
-			Image:           image,
-			ImagePullPolicy: v1.PullAlways,
-		}}},
+		ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: k.NamespaceOrDefault(namespace), Labels: podLabels},
+		Spec: v1.PodSpec{
+			Containers: []v1.Container{{
+				Name:            name,
+				Image:           options.Image,
+				ImagePullPolicy: imagePullPolicy,
+				Command:         options.Command,
+				Args:            options.Args,
+				Resources:       options.Resources,
+			}},
+			RestartPolicy:      options.RestartPolicy,
+			ServiceAccountName: options.ServiceAccount,
+		},
+	}
+	for _, envName := range slices.Sorted(maps.Keys(options.Env)) {
+		pod.Spec.Containers[0].Env = append(pod.Spec.Containers[0].Env, v1.EnvVar{Name: envName, Value: options.Env[envName]})
 	}
+	port := options.Port
 	resources = append(resources, pod)
 	if port > 0 {
 		pod.Spec.Containers[0].Ports = []v1.ContainerPort{{ContainerPort: port}}
@@ -193,6 +490,259 @@ func (k *Kubernetes) PodsRun(ctx context.Context, namespace, name, image string,
 	return k.resourcesCreateOrUpdate(ctx, toCreate)
 }
 
+// PodsWaitForReady waits until the Pod is Ready, has completed, or a container can't start (e.g. image pull errors)
+// and returns the summary of its status. If the timeout expires, the last observed status is returned.
+func (k *Kubernetes) PodsWaitForReady(ctx context.Context, namespace, name string, timeout time.Duration) (*PodStatusSummary, error) {
+	namespace = k.NamespaceOrDefault(namespace)
+	pods, err := k.manager.accessControlClientSet.Pods(namespace)
+	if err != nil {
+		return nil, err
+	}
+	watchCtx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+	watcher, err := pods.Watch(watchCtx, metav1.ListOptions{FieldSelector: "metadata.name=" + name})
+	if err != nil {
+		return nil, err
+	}
+	var pod *v1.Pod
+	_, err = toolswatch.UntilWithoutRetry(watchCtx, watcher, func(event watch.Event) (bool, error) {
+		if p, ok := event.Object.(*v1.Pod); ok {
+			pod = p
+		}
+		if event.Type == watch.Deleted {
+			return false, fmt.Errorf("pod %s was deleted", name)
+		}
+		return pod != nil && isPodSettled(pod), nil
+	})
+	if err != nil && !wait.Interrupted(err) && !errors.Is(err, toolswatch.ErrWatchClosed) {
+		return nil, err
+	}
+	if pod == nil {
+		if pod, err = pods.Get(ctx, name, metav1.GetOptions{}); err != nil {
+			return nil, err
+		}
+	}
+	summary := summarizePodStatus(pod)
+	if !isPodSettled(pod) {
+		summary.Reason = "Timeout"
+		summary.Message = fmt.Sprintf("pod was not ready after %s", timeout)
+	}
+	return summary, nil
+}
+
+// podWaitingFailureReasons are the container waiting reasons that won't resolve without user intervention
+var podWaitingFailureReasons = []string{
+	"CrashLoopBackOff", "CreateContainerConfigError", "CreateContainerError", "ErrImagePull", "ImagePullBackOff", "InvalidImageName",
+}
+
+// isPodSettled returns true if the Pod is Ready, has completed, or has a container that can't start
+func isPodSettled(pod *v1.Pod) bool {
+	if pod.Status.Phase == v1.PodSucceeded || pod.Status.Phase == v1.PodFailed {
+		return true
+	}
+	for _, condition := range pod.Status.Conditions {
+		if condition.Type == v1.PodReady && condition.Status == v1.ConditionTrue {
+			return true
+		}
+	}
+	for _, cs := range pod.Status.ContainerStatuses {
+		if cs.State.Waiting != nil && slices.Contains(podWaitingFailureReasons, cs.State.Waiting.Reason) {
+			return true
+		}
+	}
+	return false
+}
+
+func summarizePodStatus(pod *v1.Pod) *PodStatusSummary {
+	summary := &PodStatusSummary{
+		Name:      pod.Name,
+		Namespace: pod.Namespace,
+		Phase:     pod.Status.Phase,
+		Reason:    pod.Status.Reason,
+		Message:   pod.Status.Message,
+	}
+	for _, condition := range pod.Status.Conditions {
+		if condition.Type == v1.PodReady {
+			summary.Ready = condition.Status == v1.ConditionTrue
+		}
+	}
+	for _, cs := range pod.Status.ContainerStatuses {
+		summary.Containers = append(summary.Containers, summarizeContainerState(cs.Name, cs.Ready, cs.RestartCount, cs.State))
+	}
+	return summary
+}
+
+func summarizeContainerState(name string, ready bool, restartCount int32, state v1.ContainerState) ContainerStatusSummary {
+	summary := ContainerStatusSummary{Name: name, Ready: ready, RestartCount: restartCount}
+	switch {
+	case state.Running != nil:
+		summary.State = "Running"
+	case state.Waiting != nil:
+		summary.State = "Waiting"
+		summary.Reason = state.Waiting.Reason
+		summary.Message = state.Waiting.Message
+	case state.Terminated != nil:
+		summary.State = "Terminated"
+		summary.Reason = state.Terminated.Reason
+		summary.Message = state.Terminated.Message
+		summary.ExitCode = &state.Terminated.ExitCode
+	default:
+		summary.State = "Unknown"
+	}
+	return summary
+}
+
+// PodDiagnosis is a troubleshooting report of a Pod including only the relevant information about its problems
+type PodDiagnosis struct {
+	Name       string               `json:"name"`
+	Namespace  string               `json:"namespace"`
+	Phase      v1.PodPhase          `json:"phase"`
+	Reason     string               `json:"reason,omitempty"`
+	Message    string               `json:"message,omitempty"`
+	Node       string               `json:"node,omitempty"`
+	Findings   []string             `json:"findings"`
+	Conditions []ConditionSummary   `json:"conditions,omitempty"`
+	Containers []ContainerDiagnosis `json:"containers,omitempty"`
+	Events     []map[string]any     `json:"events,omitempty"`
+	// NodeConditions of the node where the Pod is scheduled
+	NodeConditions []ConditionSummary `json:"nodeConditions,omitempty"`
+	// Errors found while gathering information (the diagnosis is partial)
+	Errors []string `json:"errors,omitempty"`
+}
+
+type ConditionSummary struct {
+	Type    string `json:"type"`
+	Status  string `json:"status"`
+	Reason  string `json:"reason,omitempty"`
+	Message string `json:"message,omitempty"`
+}
+
+type ContainerDiagnosis struct {
+	ContainerStatusSummary
+	Init           bool                    `json:"init,omitempty"`
+	Image          string                  `json:"image"`
+	LastTerminated *ContainerStatusSummary `json:"lastTerminated,omitempty"`
+	// PreviousLogs is the tail of the logs of the previous (terminated) container instance
+	PreviousLogs string `json:"previousLogs,omitempty"`
+}
+
+// PodsDiagnose gathers the Pod status, container states, warning events, node conditions, and previous logs
+// of restarting containers to build a troubleshooting report
+func (k *Kubernetes) PodsDiagnose(ctx context.Context, namespace, name string, tailLines int64) (*PodDiagnosis, error) {
+	namespace = k.NamespaceOrDefault(namespace)
+	pods, err := k.manager.accessControlClientSet.Pods(namespace)
+	if err != nil {
+		return nil, err
+	}
+	pod, err := pods.Get(ctx, name, metav1.GetOptions{})
+	if err != nil {
+		return nil, err
+	}
+	diagnosis := &PodDiagnosis{
+		Name:      pod.Name,
+		Namespace: pod.Namespace,
+		Phase:     pod.Status.Phase,
+		Reason:    pod.Status.Reason,
+		Message:   pod.Status.Message,
+		Node:      pod.Spec.NodeName,
+		Findings:  []string{},
+	}
+	if pod.DeletionTimestamp != nil {
+		diagnosis.Findings = append(diagnosis.Findings, fmt.Sprintf("pod is being deleted since %s", pod.DeletionTimestamp.String()))
+	}
+	if pod.Status.Reason != "" {
+		diagnosis.Findings = append(diagnosis.Findings, fmt.Sprintf("pod is %s: %s", pod.Status.Reason, pod.Status.Message))
+	}
+	for _, condition := range pod.Status.Conditions {
+		diagnosis.Conditions = append(diagnosis.Conditions, ConditionSummary{
+			Type: string(condition.Type), Status: string(condition.Status), Reason: condition.Reason, Message: condition.Message,
+		})
+		if condition.Type == v1.PodScheduled && condition.Status == v1.ConditionFalse {
+			diagnosis.Findings = append(diagnosis.Findings, fmt.Sprintf("pod is not scheduled: %s: %s", condition.Reason, condition.Message))
+		}
+	}
+	diagnoseContainers := func(statuses []v1.ContainerStatus, init bool) {
+		for _, cs := range statuses {
+			containerDiagnosis := ContainerDiagnosis{
+				ContainerStatusSummary: summarizeContainerState(cs.Name, cs.Ready, cs.RestartCount, cs.State),
+				Init:                   init,
+				Image:                  cs.Image,
+			}
+			if cs.State.Waiting != nil && cs.State.Waiting.Reason != "" && cs.State.Waiting.Reason != "ContainerCreating" && cs.State.Waiting.Reason != "PodInitializing" {
+				diagnosis.Findings = append(diagnosis.Findings, fmt.Sprintf("container %s is waiting: %s: %s", cs.Name, cs.State.Waiting.Reason, cs.State.Waiting.Message))
+			}
+			if cs.State.Terminated != nil && cs.State.Terminated.ExitCode != 0 {
+				diagnosis.Findings = append(diagnosis.Findings, fmt.Sprintf("container %s terminated with reason %s (exit code %d)", cs.Name, cs.State.Terminated.Reason, cs.State.Terminated.ExitCode))
+			}
+			if cs.LastTerminationState.Terminated != nil {
+				lastTerminated := summarizeContainerState(cs.Name, false, cs.RestartCount, cs.LastTerminationState)
+				containerDiagnosis.LastTerminated = &lastTerminated
+			}
+			if cs.RestartCount > 0 {
+				finding := fmt.Sprintf("container %s has restarted %d times", cs.Name, cs.RestartCount)
+				if containerDiagnosis.LastTerminated != nil {
+					finding += fmt.Sprintf(", last terminated with reason %s (exit code %d)", containerDiagnosis.LastTerminated.Reason, *containerDiagnosis.LastTerminated.ExitCode)
+				}
+				diagnosis.Findings = append(diagnosis.Findings, finding)
+				logOptions := PodsLogOptions{PodLogOptions: v1.PodLogOptions{Container: cs.Name, Previous: true, TailLines: ptr.To(tailLines)}}
+				if containerDiagnosis.PreviousLogs, err = k.PodsLog(ctx, namespace, name, logOptions); err != nil {
+					diagnosis.Errors = append(diagnosis.Errors, fmt.Sprintf("failed to get previous logs of container %s: %v", cs.Name, err))
+				}
+			}
+			diagnosis.Containers = append(diagnosis.Containers, containerDiagnosis)
+		}
+	}
+	diagnoseContainers(pod.Status.InitContainerStatuses, true)
+	diagnoseContainers(pod.Status.ContainerStatuses, false)
+	// Only the events of the Pod are retrieved (server-side filtering) instead of every event in the namespace
+	events, err := k.eventsList(ctx, namespace, ResourceListOptions{ListOptions: metav1.ListOptions{
+		FieldSelector: fields.AndSelectors(
+			fields.OneTermEqualSelector("involvedObject.kind", "Pod"),
+			fields.OneTermEqualSelector("involvedObject.name", name),
+		).String(),
+	}})
+	if err != nil {
+		diagnosis.Errors = append(diagnosis.Errors, fmt.Sprintf("failed to list events: %v", err))
+	}
+	for _, event := range events {
+		involvedObject := event["InvolvedObject"].(map[string]string)
+		if event["Type"] == v1.EventTypeWarning && involvedObject["Kind"] == "Pod" && involvedObject["Name"] == name {
+			delete(event, "Namespace")
+			delete(event, "InvolvedObject")
+			diagnosis.Events = append(diagnosis.Events, event)
+		}
+	}
+	if pod.Spec.NodeName != "" {
+		k.podsDiagnoseNode(ctx, diagnosis)
+	}
+	return diagnosis, nil
+}
+
+func (k *Kubernetes) podsDiagnoseNode(ctx context.Context, diagnosis *PodDiagnosis) {
+	unstructuredNode, err := k.ResourcesGet(ctx, &schema.GroupVersionKind{Group: "", Version: "v1", Kind: "Node"}, "", diagnosis.Node)
+	if err != nil {
+		diagnosis.Errors = append(diagnosis.Errors, fmt.Sprintf("failed to get node %s: %v", diagnosis.Node, err))
+		return
+	}
+	node := &v1.Node{}
+	if err = runtime.DefaultUnstructuredConverter.FromUnstructured(unstructuredNode.Object, node); err != nil {
+		diagnosis.Errors = append(diagnosis.Errors, fmt.Sprintf("failed to get node %s: %v", diagnosis.Node, err))
+		return
+	}
+	if node.Spec.Unschedulable {
+		diagnosis.Findings = append(diagnosis.Findings, fmt.Sprintf("node %s is cordoned", node.Name))
+	}
+	for _, condition := range node.Status.Conditions {
+		diagnosis.NodeConditions = append(diagnosis.NodeConditions, ConditionSummary{
+			Type: string(condition.Type), Status: string(condition.Status), Reason: condition.Reason, Message: condition.Message,
+		})
+		// Ready is the only node condition that signals a problem when not True (MemoryPressure, DiskPressure, etc. when True)
+		if (condition.Type == v1.NodeReady) != (condition.Status == v1.ConditionTrue) {
+			diagnosis.Findings = append(diagnosis.Findings, fmt.Sprintf("node %s has condition %s=%s: %s", node.Name, condition.Type, condition.Status, condition.Message))
+		}
+	}
+}
+
 func (k *Kubernetes) PodsTop(ctx context.Context, options PodsTopOptions) (*metrics.PodMetricsList, error) {
 	// TODO, maybe move to mcp Tools setup and omit in case metrics aren't available in the target cluster
 	if !k.supportsGroupVersion(metrics.GroupName + "/" + metricsv1beta1api.SchemeGroupVersion.Version) {
@@ -204,48 +754,351 @@ func (k *Kubernetes) PodsTop(ctx context.Context, options PodsTopOptions) (*metr
 	} else {
 		namespace = k.NamespaceOrDefault(namespace)
 	}
-	return k.manager.accessControlClientSet.PodsMetricses(ctx, namespace, options.Name, options.ListOptions)
+	ret, err := k.manager.accessControlClientSet.PodsMetricses(ctx, namespace, options.Name, options.ListOptions)
+	if err != nil {
+		return nil, err
+	}
+	sort.Sort(metricsutil.NewPodMetricsSorter(ret.Items, true, options.SortBy))
+	if options.Limit > 0 && len(ret.Items) > options.Limit {
+		ret.Items = ret.Items[:options.Limit]
+	}
+	return ret, nil
 }
 
-func (k *Kubernetes) PodsExec(ctx context.Context, namespace, name, container string, command []string) (string, error) {
+func (k *Kubernetes) PodsExec(ctx context.Context, namespace, name string, options PodsExecOptions) (*PodsExecResult, error) {
 	namespace = k.NamespaceOrDefault(namespace)
 	pods, err := k.manager.accessControlClientSet.Pods(namespace)
 	if err != nil {
-		return "", err
+		return nil, err
 	}
 	pod, err := pods.Get(ctx, name, metav1.GetOptions{})
 	if err != nil {
-		return "", err
+		return nil, err
 	}
 	// https://github.com/kubernetes/kubectl/blob/5366de04e168bcbc11f5e340d131a9ca8b7d0df4/pkg/cmd/exec/exec.go#L350-L352
 	if pod.Status.Phase == v1.PodSucceeded || pod.Status.Phase == v1.PodFailed {
-		return "", fmt.Errorf("cannot exec into a container in a completed pod; current phase is %s", pod.Status.Phase)
+		return nil, fmt.Errorf("cannot exec into a container in a completed pod; current phase is %s", pod.Status.Phase)
 	}
+	container := options.Container
 	if container == "" {
 		container = pod.Spec.Containers[0].Name
 	}
 	podExecOptions := &v1.PodExecOptions{
 		Container: container,
-		Command:   command,
+		Command:   options.Command,
+		Stdin:     options.Stdin != nil,
 		Stdout:    true,
 		Stderr:    true,
 	}
 	executor, err := k.manager.accessControlClientSet.PodsExec(namespace, name, podExecOptions)
 	if err != nil {
-		return "", err
+		return nil, err
 	}
-	stdout := bytes.NewBuffer(make([]byte, 0))
-	stderr := bytes.NewBuffer(make([]byte, 0))
-	if err = executor.StreamWithContext(ctx, remotecommand.StreamOptions{
-		Stdout: stdout, Stderr: stderr, Tty: false,
-	}); err != nil {
-		return "", err
+	if options.Timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, options.Timeout)
+		defer cancel()
+	}
+	streamCtx, cancelStream := context.WithCancel(ctx)
+	defer cancelStream()
+	stdout := &podsExecBuffer{limit: options.MaxStdoutBytes, exceeded: cancelStream}
+	stderr := &podsExecBuffer{}
+	err = executor.StreamWithContext(streamCtx, remotecommand.StreamOptions{
+		Stdin: options.Stdin, Stdout: stdout, Stderr: stderr, Tty: false,
+	})
+	if stdout.limitExceeded() {
+		return nil, fmt.Errorf("command output exceeds the maximum size of %d bytes", options.MaxStdoutBytes)
+	}
+	result := &PodsExecResult{Stdout: stdout.String(), Stderr: stderr.String()}
+	// A command that ran but exited with a non-zero status is not an exec failure
+	var exitErr utilexec.ExitError
+	if errors.As(err, &exitErr) && exitErr.Exited() {
+		result.ExitCode = exitErr.ExitStatus()
+		return result, nil
+	}
+	// The output produced before the timeout is returned along with the error
+	if err != nil && errors.Is(ctx.Err(), context.DeadlineExceeded) {
+		return result, fmt.Errorf("command timed out after %s", options.Timeout)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// podsExecBuffer is a thread-safe buffer, the streams might still be written after the exec returns (timeout).
+// If a limit is set, the exceeded function is called (once) to abort the command when the limit is exceeded.
+type podsExecBuffer struct {
+	mu       sync.Mutex
+	buf      bytes.Buffer
+	limit    int64
+	exceeded func()
+	overflow bool
+}
+
+func (b *podsExecBuffer) Write(p []byte) (int, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if b.overflow {
+		return 0, io.ErrShortWrite
+	}
+	if b.limit > 0 && int64(b.buf.Len()+len(p)) > b.limit {
+		b.overflow = true
+		b.exceeded()
+		return 0, io.ErrShortWrite
+	}
+	return b.buf.Write(p)
+}
+
+func (b *podsExecBuffer) limitExceeded() bool {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return b.overflow
+}
+
+func (b *podsExecBuffer) String() string {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return b.buf.String()
+}
+
+type PodsDebugOptions struct {
+	// Name of the ephemeral container (random name if not provided)
+	Container string
+	Image     string
+	// TargetContainer is the Pod container whose process namespace is shared with the ephemeral container
+	TargetContainer string
+	// Command to execute in the ephemeral container once started (Optional)
+	Command []string
+	// Timeout to wait for the ephemeral container to start and for the command to complete
+	Timeout time.Duration
+}
+
+type PodsDebugResult struct {
+	Container ContainerStatusSummary `json:"container"`
+	Exec      *PodsExecResult        `json:"-"`
+}
+
+// PodsDebug adds an ephemeral container to a running Pod and waits for it to start.
+// Ephemeral containers can't be removed, once added the result is returned along with any later error (e.g. the command failed).
+// https://github.com/kubernetes/kubectl/blob/5366de04e168bcbc11f5e340d131a9ca8b7d0df4/pkg/cmd/debug/debug.go#L539-L597
+func (k *Kubernetes) PodsDebug(ctx context.Context, namespace, name string, options PodsDebugOptions) (*PodsDebugResult, error) {
+	namespace = k.NamespaceOrDefault(namespace)
+	if options.Image == "" {
+		return nil, errors.New("an image is required")
+	}
+	if options.Timeout <= 0 {
+		options.Timeout = 2 * time.Minute
+	}
+	pods, err := k.manager.accessControlClientSet.Pods(namespace)
+	if err != nil {
+		return nil, err
+	}
+	pod, err := pods.Get(ctx, name, metav1.GetOptions{})
+	if err != nil {
+		return nil, err
+	}
+	if pod.Status.Phase == v1.PodSucceeded || pod.Status.Phase == v1.PodFailed {
+		return nil, fmt.Errorf("cannot debug a completed pod; current phase is %s", pod.Status.Phase)
+	}
+	if options.TargetContainer != "" && !slices.ContainsFunc(pod.Spec.Containers, func(c v1.Container) bool {
+		return c.Name == options.TargetContainer
+	}) {
+		return nil, fmt.Errorf("container %s not found in pod %s", options.TargetContainer, name)
+	}
+	if options.Container == "" {
+		options.Container = "debugger-" + rand.String(5)
+	}
+	for _, ec := range pod.Spec.EphemeralContainers {
+		if ec.Name == options.Container {
+			return nil, fmt.Errorf("ephemeral container %s already exists in pod %s", options.Container, name)
+		}
+	}
+	// Stdin keeps the default shell of the debug image alive so that commands can be executed in the container
+	pod.Spec.EphemeralContainers = append(pod.Spec.EphemeralContainers, v1.EphemeralContainer{
+		EphemeralContainerCommon: v1.EphemeralContainerCommon{
+			Name:                     options.Container,
+			Image:                    options.Image,
+			ImagePullPolicy:          v1.PullIfNotPresent,
+			Stdin:                    true,
+			TerminationMessagePolicy: v1.TerminationMessageFallbackToLogsOnError,
+		},
+		TargetContainerName: options.TargetContainer,
+	})
+	if _, err = k.manager.accessControlClientSet.PodsUpdateEphemeralContainers(ctx, pod); err != nil {
+		return nil, err
+	}
+	status, err := podsWaitForEphemeralContainer(ctx, pods, name, options.Container, options.Timeout)
+	if err != nil {
+		return &PodsDebugResult{Container: ContainerStatusSummary{Name: options.Container, State: "Unknown"}}, err
+	}
+	result := &PodsDebugResult{Container: *status}
+	if len(options.Command) == 0 || status.State != "Running" {
+		return result, nil
+	}
+	result.Exec, err = k.PodsExec(ctx, namespace, name, PodsExecOptions{
+		Container: options.Container,
+		Command:   options.Command,
+		Timeout:   options.Timeout,
+	})
+	return result, err
+}
+
+// podsWaitForEphemeralContainer waits until the ephemeral container is running, has terminated, or can't start
+func podsWaitForEphemeralContainer(ctx context.Context, pods corev1.PodInterface, name, container string, timeout time.Duration) (*ContainerStatusSummary, error) {
+	watchCtx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+	watcher, err := pods.Watch(watchCtx, metav1.ListOptions{FieldSelector: "metadata.name=" + name})
+	if err != nil {
+		return nil, err
+	}
+	var status *ContainerStatusSummary
+	_, err = toolswatch.UntilWithoutRetry(watchCtx, watcher, func(event watch.Event) (bool, error) {
+		if event.Type == watch.Deleted {
+			return false, fmt.Errorf("pod %s was deleted", name)
+		}
+		pod, ok := event.Object.(*v1.Pod)
+		if !ok {
+			return false, nil
+		}
+		for _, cs := range pod.Status.EphemeralContainerStatuses {
+			if cs.Name == container {
+				summary := summarizeContainerState(cs.Name, cs.Ready, cs.RestartCount, cs.State)
+				status = &summary
+				return cs.State.Running != nil || cs.State.Terminated != nil ||
+					(cs.State.Waiting != nil && slices.Contains(podWaitingFailureReasons, cs.State.Waiting.Reason)), nil
+			}
+		}
+		return false, nil
+	})
+	if err != nil && !wait.Interrupted(err) && !errors.Is(err, toolswatch.ErrWatchClosed) {
+		return nil, err
+	}
+	if status == nil {
+		status = &ContainerStatusSummary{Name: container, State: "Unknown"}
 	}
-	if stdout.Len() > 0 {
-		return stdout.String(), nil
+	if status.State != "Running" && status.State != "Terminated" && status.Reason == "" {
+		status.Reason = "Timeout"
+		status.Message = fmt.Sprintf("ephemeral container was not running after %s", timeout)
+	}
+	return status, nil
+}
+
+// PodsCpFile is a file copied from or to a Pod container, binary content is base64 encoded
+type PodsCpFile struct {
+	Path     string `json:"path"`
+	Size     int64  `json:"size,omitempty"`
+	Encoding string `json:"encoding"`
+	Content  string `json:"content"`
+}
+
+const (
+	PodsCpEncodingText   = "text"
+	PodsCpEncodingBase64 = "base64"
+	// PodsCpMaxArchiveBytes is the maximum size of the tar archive read from the container (10MiB)
+	PodsCpMaxArchiveBytes = 10 * 1024 * 1024
+)
+
+// PodsCpFrom reads a file or a directory (recursively) from a Pod container by streaming a tar archive over exec
+// https://github.com/kubernetes/kubectl/blob/5366de04e168bcbc11f5e340d131a9ca8b7d0df4/pkg/cmd/cp/cp.go#L305-L320
+func (k *Kubernetes) PodsCpFrom(ctx context.Context, namespace, name, container, srcPath string) ([]PodsCpFile, error) {
+	if srcPath == "" {
+		return nil, errors.New("source path is required")
+	}
+	srcPath = path.Clean(srcPath)
+	dir, base := path.Split(srcPath)
+	if dir == "" {
+		dir = "."
+	}
+	// "--" prevents the file name from being interpreted as a tar option (e.g. --to-command=...)
+	ret, err := k.PodsExec(ctx, namespace, name, PodsExecOptions{
+		Container:      container,
+		Command:        []string{"tar", "cf", "-", "-C", dir, "--", base},
+		MaxStdoutBytes: PodsCpMaxArchiveBytes,
+	})
+	if err != nil {
+		return nil, err
+	}
+	if ret.ExitCode != 0 {
+		return nil, fmt.Errorf("tar exited with code %d: %s", ret.ExitCode, strings.TrimSpace(ret.Stderr))
+	}
+	var files []PodsCpFile
+	tarReader := tar.NewReader(strings.NewReader(ret.Stdout))
+	for {
+		header, tarErr := tarReader.Next()
+		if errors.Is(tarErr, io.EOF) {
+			break
+		}
+		if tarErr != nil {
+			return nil, fmt.Errorf("failed to read tar archive: %w", tarErr)
+		}
+		if header.Typeflag != tar.TypeReg {
+			continue
+		}
+		content, readErr := io.ReadAll(tarReader)
+		if readErr != nil {
+			return nil, fmt.Errorf("failed to read %s from tar archive: %w", header.Name, readErr)
+		}
+		file := PodsCpFile{Path: path.Join(dir, header.Name), Size: header.Size, Encoding: PodsCpEncodingText}
+		if utf8.Valid(content) && !bytes.ContainsRune(content, 0) {
+			file.Content = string(content)
+		} else {
+			file.Encoding = PodsCpEncodingBase64
+			file.Content = base64.StdEncoding.EncodeToString(content)
+		}
+		files = append(files, file)
+	}
+	return files, nil
+}
+
+// PodsCpTo writes the provided files (absolute paths) into a Pod container by streaming a tar archive over exec
+func (k *Kubernetes) PodsCpTo(ctx context.Context, namespace, name, container string, files []PodsCpFile) error {
+	if len(files) == 0 {
+		return errors.New("at least one file is required")
+	}
+	archive := bytes.NewBuffer(make([]byte, 0))
+	tarWriter := tar.NewWriter(archive)
+	for _, file := range files {
+		if !path.IsAbs(file.Path) {
+			return fmt.Errorf("file path must be absolute: %s", file.Path)
+		}
+		content := []byte(file.Content)
+		switch file.Encoding {
+		case "", PodsCpEncodingText:
+		case PodsCpEncodingBase64:
+			var err error
+			if content, err = base64.StdEncoding.DecodeString(file.Content); err != nil {
+				return fmt.Errorf("invalid base64 content for %s: %w", file.Path, err)
+			}
+		default:
+			return fmt.Errorf("unsupported encoding %q for %s (supported: text, base64)", file.Encoding, file.Path)
+		}
+		if err := tarWriter.WriteHeader(&tar.Header{
+			Typeflag: tar.TypeReg,
+			Name:     strings.TrimPrefix(path.Clean(file.Path), "/"),
+			Mode:     0644,
+			Size:     int64(len(content)),
+			ModTime:  time.Now(),
+		}); err != nil {
+			return err
+		}
+		if _, err := tarWriter.Write(content); err != nil {
+			return err
+		}
+	}
+	if err := tarWriter.Close(); err != nil {
+		return err
+	}
+	ret, err := k.PodsExec(ctx, namespace, name, PodsExecOptions{
+		Container: container,
+		Command:   []string{"tar", "xf", "-", "-C", "/"},
+		Stdin:     archive,
+	})
+	if err != nil {
+		return err
 	}
-	if stderr.Len() > 0 {
-		return stderr.String(), nil
+	if ret.ExitCode != 0 {
+		return fmt.Errorf("tar exited with code %d: %s", ret.ExitCode, strings.TrimSpace(ret.Stderr))
 	}
-	return "", nil
+	return nil
 }
@@ -0,0 +1,274 @@
+package kubernetes
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	labelutil "k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/apimachinery/pkg/util/rand"
+	"k8s.io/client-go/tools/portforward"
+	"k8s.io/klog/v2"
+)
+
+type PortForwardOptions struct {
+	Namespace string
+	// Pod or Service to forward the port to (only one of them should be provided)
+	Pod     string
+	Service string
+	// LocalPort is the port to listen on the server host (random port if 0)
+	LocalPort int32
+	// RemotePort is the Pod container port, or the Service port if a Service is provided
+	RemotePort int32
+}
+
+// portForwardStopTimeout bounds the wait for a session to finish once stopped (e.g. a Dial to the API server is in flight)
+const portForwardStopTimeout = 5 * time.Second
+
+// PortForward is an active port-forward session from the server host to a Pod
+type PortForward struct {
+	ID         string    `json:"id"`
+	Namespace  string    `json:"namespace"`
+	Pod        string    `json:"pod"`
+	Service    string    `json:"service,omitempty"`
+	Address    string    `json:"address"`
+	LocalPort  uint16    `json:"localPort"`
+	RemotePort uint16    `json:"remotePort"`
+	StartTime  time.Time `json:"startTime"`
+	// owner is the MCP session (or user) that started the port-forward session
+	owner    string
+	stopCh   chan struct{}
+	doneCh   chan struct{}
+	stopOnce sync.Once
+}
+
+// Stop closes the port-forward session and waits (up to portForwardStopTimeout) for it to finish
+func (pf *PortForward) Stop() {
+	pf.stopOnce.Do(func() { close(pf.stopCh) })
+	select {
+	case <-pf.doneCh:
+	case <-time.After(portForwardStopTimeout):
+		klog.V(1).Infof("port-forward session %s didn't finish after %s, it will be released in the background", pf.ID, portForwardStopTimeout)
+	}
+}
+
+// Done returns a channel that's closed when the port-forward session finishes
+func (pf *PortForward) Done() <-chan struct{} {
+	return pf.doneCh
+}
+
+func (k *Kubernetes) PortForwardStart(ctx context.Context, options PortForwardOptions) (*PortForward, error) {
+	namespace := k.NamespaceOrDefault(options.Namespace)
+	if options.Pod == "" && options.Service == "" {
+		return nil, errors.New("either a pod or a service is required")
+	}
+	pods, err := k.manager.accessControlClientSet.Pods(namespace)
+	if err != nil {
+		return nil, err
+	}
+	var pod *v1.Pod
+	remotePort := options.RemotePort
+	if options.Service != "" {
+		pod, remotePort, err = k.portForwardServiceTarget(ctx, namespace, options.Service, options.RemotePort)
+	} else {
+		pod, err = pods.Get(ctx, options.Pod, metav1.GetOptions{})
+	}
+	if err != nil {
+		return nil, err
+	}
+	if pod.Status.Phase != v1.PodRunning {
+		return nil, fmt.Errorf("unable to forward port because pod %s is not running, current status=%v", pod.Name, pod.Status.Phase)
+	}
+	if remotePort <= 0 {
+		return nil, errors.New("a remote port is required")
+	}
+	dialer, err := k.manager.accessControlClientSet.PodsPortForward(namespace, pod.Name)
+	if err != nil {
+		return nil, err
+	}
+	pf := &PortForward{
+		ID:        "pf-" + rand.String(5),
+		Namespace: namespace,
+		Pod:       pod.Name,
+		Service:   options.Service,
+		Address:   "127.0.0.1",
+		StartTime: time.Now(),
+		stopCh:    make(chan struct{}),
+		doneCh:    make(chan struct{}),
+	}
+	readyCh := make(chan struct{})
+	forwarder, err := portforward.NewOnAddresses(dialer, []string{pf.Address},
+		[]string{fmt.Sprintf("%d:%d", options.LocalPort, remotePort)}, pf.stopCh, readyCh, io.Discard, io.Discard)
+	if err != nil {
+		return nil, err
+	}
+	errCh := make(chan error, 1)
+	go func() {
+		errCh <- forwarder.ForwardPorts()
+		close(pf.doneCh)
+	}()
+	select {
+	case <-readyCh:
+	case err = <-errCh:
+		return nil, err
+	case <-ctx.Done():
+		pf.Stop()
+		return nil, ctx.Err()
+	}
+	ports, err := forwarder.GetPorts()
+	if err != nil || len(ports) == 0 {
+		pf.Stop()
+		return nil, fmt.Errorf("failed to retrieve forwarded ports: %v", err)
+	}
+	pf.LocalPort = ports[0].Local
+	pf.RemotePort = ports[0].Remote
+	return pf, nil
+}
+
+// portForwardServiceTarget resolves a running Pod backing the Service and the container port matching the Service port
+// https://github.com/kubernetes/kubectl/blob/5366de04e168bcbc11f5e340d131a9ca8b7d0df4/pkg/cmd/portforward/portforward.go#L203-L240
+func (k *Kubernetes) portForwardServiceTarget(ctx context.Context, namespace, name string, port int32) (*v1.Pod, int32, error) {
+	services, err := k.manager.accessControlClientSet.Services(namespace)
+	if err != nil {
+		return nil, 0, err
+	}
+	svc, err := services.Get(ctx, name, metav1.GetOptions{})
+	if err != nil {
+		return nil, 0, err
+	}
+	if len(svc.Spec.Selector) == 0 {
+		return nil, 0, fmt.Errorf("service %s has no selector", name)
+	}
+	if port == 0 && len(svc.Spec.Ports) > 1 {
+		ports := make([]string, 0, len(svc.Spec.Ports))
+		for _, p := range svc.Spec.Ports {
+			ports = append(ports, strconv.Itoa(int(p.Port)))
+		}
+		return nil, 0, fmt.Errorf("service %s exposes multiple ports (%s), the remote port is required to select one of them", name, strings.Join(ports, ", "))
+	}
+	var servicePort *v1.ServicePort
+	for i := range svc.Spec.Ports {
+		if svc.Spec.Ports[i].Port == port || (port == 0 && len(svc.Spec.Ports) == 1) {
+			servicePort = &svc.Spec.Ports[i]
+			break
+		}
+	}
+	if servicePort == nil {
+		return nil, 0, fmt.Errorf("service %s does not have a service port %d", name, port)
+	}
+	pods, err := k.manager.accessControlClientSet.Pods(namespace)
+	if err != nil {
+		return nil, 0, err
+	}
+	podList, err := pods.List(ctx, metav1.ListOptions{LabelSelector: labelutil.SelectorFromSet(svc.Spec.Selector).String()})
+	if err != nil {
+		return nil, 0, err
+	}
+	var pod *v1.Pod
+	for i := range podList.Items {
+		if podList.Items[i].Status.Phase == v1.PodRunning && podList.Items[i].DeletionTimestamp == nil {
+			pod = &podList.Items[i]
+			break
+		}
+	}
+	if pod == nil {
+		return nil, 0, fmt.Errorf("service %s has no running pods", name)
+	}
+	if servicePort.TargetPort.Type == intstr.Int {
+		if servicePort.TargetPort.IntVal == 0 {
+			return pod, servicePort.Port, nil
+		}
+		return pod, servicePort.TargetPort.IntVal, nil
+	}
+	for _, container := range pod.Spec.Containers {
+		for _, containerPort := range container.Ports {
+			if containerPort.Name == servicePort.TargetPort.StrVal {
+				return pod, containerPort.ContainerPort, nil
+			}
+		}
+	}
+	return nil, 0, fmt.Errorf("pod %s does not have a named port %s", pod.Name, servicePort.TargetPort.StrVal)
+}
+
+// PortForwardRegistry keeps track of the active port-forward sessions.
+// Sessions are scoped to the owner (MCP session or user) that started them, owners can't list or stop the sessions of others.
+type PortForwardRegistry struct {
+	mu       sync.Mutex
+	sessions map[string]*PortForward
+}
+
+func NewPortForwardRegistry() *PortForwardRegistry {
+	return &PortForwardRegistry{sessions: make(map[string]*PortForward)}
+}
+
+// Add registers the session for the owner and removes it from the registry once it finishes
+func (r *PortForwardRegistry) Add(owner string, pf *PortForward) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	pf.owner = owner
+	r.sessions[pf.ID] = pf
+	go func() {
+		<-pf.Done()
+		r.mu.Lock()
+		defer r.mu.Unlock()
+		delete(r.sessions, pf.ID)
+	}()
+}
+
+// List returns the active sessions of the owner sorted by start time
+func (r *PortForwardRegistry) List(owner string) []*PortForward {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	ret := make([]*PortForward, 0, len(r.sessions))
+	for _, pf := range r.sessions {
+		if pf.owner == owner {
+			ret = append(ret, pf)
+		}
+	}
+	sort.Slice(ret, func(i, j int) bool { return ret[i].StartTime.Before(ret[j].StartTime) })
+	return ret
+}
+
+// Stop stops the session with the provided id if it belongs to the owner
+func (r *PortForwardRegistry) Stop(owner, id string) error {
+	r.mu.Lock()
+	pf, ok := r.sessions[id]
+	if ok && pf.owner == owner {
+		delete(r.sessions, id)
+	}
+	r.mu.Unlock()
+	if !ok || pf.owner != owner {
+		return fmt.Errorf("port-forward session %s not found", id)
+	}
+	pf.Stop()
+	return nil
+}
+
+// StopAll stops all the active sessions of the owner (e.g. the MCP session is closed)
+func (r *PortForwardRegistry) StopAll(owner string) {
+	for _, pf := range r.List(owner) {
+		_ = r.Stop(owner, pf.ID)
+	}
+}
+
+// Close stops all the active sessions
+func (r *PortForwardRegistry) Close() {
+	r.mu.Lock()
+	sessions := make([]*PortForward, 0, len(r.sessions))
+	for _, pf := range r.sessions {
+		sessions = append(sessions, pf)
+	}
+	r.mu.Unlock()
+	for _, pf := range sessions {
+		pf.Stop()
+	}
+}
@@ -2,18 +2,34 @@ package kubernetes
 
 import (
 	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
 	"fmt"
 	"k8s.io/apimachinery/pkg/runtime"
 	"regexp"
+	"slices"
+	"sort"
+	"strconv"
 	"strings"
+	"time"
 
 	"github.com/manusa/kubernetes-mcp-server/pkg/version"
+	"github.com/pmezard/go-difflib/difflib"
 	authv1 "k8s.io/api/authorization/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	metav1beta1 "k8s.io/apimachinery/pkg/apis/meta/v1beta1"
 	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	utilerrors "k8s.io/apimachinery/pkg/util/errors"
+	"k8s.io/apimachinery/pkg/util/wait"
 	"k8s.io/apimachinery/pkg/util/yaml"
+	"k8s.io/client-go/dynamic"
+	"k8s.io/client-go/restmapper"
+	yml "sigs.k8s.io/yaml"
 )
 
 const (
@@ -23,11 +39,106 @@ const (
 	AppKubernetesPartOf    = "app.kubernetes.io/part-of"
 )
 
+// resourcesApplyConflictManager matches the (quoted) manager in the conflict messages (e.g. conflict with "kubectl" using apps/v1)
+var resourcesApplyConflictManager = regexp.MustCompile(`^conflict with ("(?:[^"\\]|\\.)*")`)
+
+// resourcesPatchTypes maps the supported patch types to their Content-Type
+var resourcesPatchTypes = map[string]types.PatchType{
+	"json":      types.JSONPatchType,
+	"merge":     types.MergePatchType,
+	"strategic": types.StrategicMergePatchType,
+	"apply":     types.ApplyPatchType,
+}
+
 type ResourceListOptions struct {
 	metav1.ListOptions
 	AsTable bool
 }
 
+type ResourcesCreateOrUpdateOptions struct {
+	// DryRun performs a server-side dry-run, the resources are validated and defaulted by the server but not persisted
+	DryRun bool
+	// Force the server-side apply, the field manager takes ownership of the fields owned by other field managers
+	Force bool
+	// FieldManager name used for the server-side apply (defaults to version.BinaryName)
+	FieldManager string
+}
+
+// ResourcesApplyConflict is a field owned by another field manager that prevents the server-side apply
+type ResourcesApplyConflict struct {
+	// Field path (e.g. .spec.replicas)
+	Field string `json:"field"`
+	// Manager that owns the field (e.g. kubectl-client-side-apply, argocd-controller)
+	Manager string `json:"manager"`
+	// Message as reported by the server, includes the apiVersion and time of the manager operation (if available)
+	Message string `json:"message"`
+}
+
+// ResourcesApplyConflictError is returned when the server-side apply of a resource fails because some of its fields are owned by other field managers
+type ResourcesApplyConflictError struct {
+	APIVersion string                   `json:"apiVersion"`
+	Kind       string                   `json:"kind"`
+	Namespace  string                   `json:"namespace,omitempty"`
+	Name       string                   `json:"name"`
+	Conflicts  []ResourcesApplyConflict `json:"conflicts"`
+}
+
+func (e *ResourcesApplyConflictError) Error() string {
+	fields := make([]string, 0, len(e.Conflicts))
+	for _, c := range e.Conflicts {
+		fields = append(fields, fmt.Sprintf("%s (owned by %s)", c.Field, c.Manager))
+	}
+	return fmt.Sprintf("apply of %s %s failed with %d conflict(s): %s", e.Kind, e.Name, len(e.Conflicts), strings.Join(fields, ", "))
+}
+
+type ResourcesDeleteOptions struct {
+	// PropagationPolicy of the dependents (Foreground, Background, or Orphan), server default if not provided
+	PropagationPolicy string
+	// GracePeriodSeconds before the resource is deleted, server default if nil
+	GracePeriodSeconds *int64
+	// DryRun performs a server-side dry-run, the resources are not deleted
+	DryRun bool
+}
+
+func (o ResourcesDeleteOptions) deleteOptions() (metav1.DeleteOptions, error) {
+	deleteOptions := metav1.DeleteOptions{GracePeriodSeconds: o.GracePeriodSeconds}
+	if o.PropagationPolicy != "" {
+		for _, policy := range []metav1.DeletionPropagation{metav1.DeletePropagationForeground, metav1.DeletePropagationBackground, metav1.DeletePropagationOrphan} {
+			if strings.EqualFold(string(policy), o.PropagationPolicy) {
+				deleteOptions.PropagationPolicy = &policy
+			}
+		}
+		if deleteOptions.PropagationPolicy == nil {
+			return deleteOptions, fmt.Errorf("invalid propagation policy %q, must be one of: Foreground, Background, Orphan", o.PropagationPolicy)
+		}
+	}
+	if o.DryRun {
+		deleteOptions.DryRun = []string{metav1.DryRunAll}
+	}
+	return deleteOptions, nil
+}
+
+type ResourcesScaleOptions struct {
+	// Replicas to scale the resource to (the current scale is returned if nil)
+	Replicas *int64
+	// Timeout to wait until the ready replicas converge to the desired replicas (no wait if 0)
+	Timeout time.Duration
+}
+
+type ResourcesScaleResult struct {
+	APIVersion       string `json:"apiVersion"`
+	Kind             string `json:"kind"`
+	Namespace        string `json:"namespace,omitempty"`
+	Name             string `json:"name"`
+	PreviousReplicas int64  `json:"previousReplicas"`
+	Replicas         int64  `json:"replicas"`
+	CurrentReplicas  int64  `json:"currentReplicas"`
+	ReadyReplicas    *int64 `json:"readyReplicas,omitempty"`
+	Selector         string `json:"selector,omitempty"`
+	// Message describing the outcome of the wait (if requested)
+	Message string `json:"message,omitempty"`
+}
+
 func (k *Kubernetes) ResourcesList(ctx context.Context, gvk *schema.GroupVersionKind, namespace string, options ResourceListOptions) (runtime.Unstructured, error) {
 	gvr, err := k.resourceFor(gvk)
 	if err != nil {
@@ -58,21 +169,67 @@ func (k *Kubernetes) ResourcesGet(ctx context.Context, gvk *schema.GroupVersionK
 	return k.manager.dynamicClient.Resource(*gvr).Namespace(namespace).Get(ctx, name, metav1.GetOptions{})
 }
 
-func (k *Kubernetes) ResourcesCreateOrUpdate(ctx context.Context, resource string) ([]*unstructured.Unstructured, error) {
-	separator := regexp.MustCompile(`\r?\n---\r?\n`)
-	resources := separator.Split(resource, -1)
-	var parsedResources []*unstructured.Unstructured
-	for _, r := range resources {
-		var obj unstructured.Unstructured
-		if err := yaml.NewYAMLToJSONDecoder(strings.NewReader(r)).Decode(&obj); err != nil {
-			return nil, err
+func (k *Kubernetes) ResourcesCreateOrUpdate(ctx context.Context, resource string, options ResourcesCreateOrUpdateOptions) ([]*unstructured.Unstructured, error) {
+	parsedResources, err := parseResources(resource)
+	if err != nil {
+		return nil, err
+	}
+	return k.resourcesApply(ctx, parsedResources, options)
+}
+
+// ResourcesDiff performs a server-side dry-run apply of the provided resources and returns a unified diff
+// between the live resources and the dry-run result (empty if there are no changes).
+// Fields managed by the server that change on every update (managedFields, resourceVersion, ...) are ignored.
+func (k *Kubernetes) ResourcesDiff(ctx context.Context, resource string, options ResourcesCreateOrUpdateOptions) (string, error) {
+	options.DryRun = true
+	parsedResources, err := parseResources(resource)
+	if err != nil {
+		return "", err
+	}
+	diff := strings.Builder{}
+	for _, obj := range parsedResources {
+		gvk := obj.GroupVersionKind()
+		live, err := k.ResourcesGet(ctx, &gvk, obj.GetNamespace(), obj.GetName())
+		if apierrors.IsNotFound(err) {
+			live = nil
+		} else if err != nil {
+			return "", err
 		}
-		parsedResources = append(parsedResources, &obj)
+		merged, err := k.resourcesApply(ctx, []*unstructured.Unstructured{obj}, options)
+		if err != nil {
+			return "", err
+		}
+		liveYaml, err := resourcesDiffYaml(live)
+		if err != nil {
+			return "", err
+		}
+		mergedYaml, err := resourcesDiffYaml(merged[0])
+		if err != nil {
+			return "", err
+		}
+		name := strings.Join(slices.DeleteFunc([]string{gvk.Group, gvk.Version, gvk.Kind, merged[0].GetNamespace(), merged[0].GetName()}, func(s string) bool {
+			return s == ""
+		}), ".")
+		resourceDiff, err := difflib.GetUnifiedDiffString(difflib.UnifiedDiff{
+			A:        resourcesDiffLines(liveYaml),
+			B:        resourcesDiffLines(mergedYaml),
+			FromFile: "live/" + name,
+			ToFile:   "merged/" + name,
+			Context:  3,
+		})
+		if err != nil {
+			return "", err
+		}
+		diff.WriteString(resourceDiff)
 	}
-	return k.resourcesCreateOrUpdate(ctx, parsedResources)
+	return diff.String(), nil
 }
 
-func (k *Kubernetes) ResourcesDelete(ctx context.Context, gvk *schema.GroupVersionKind, namespace, name string) error {
+func (k *Kubernetes) ResourcesDelete(ctx context.Context, gvk *schema.GroupVersionKind, namespace, name string, options ResourcesDeleteOptions) error {
+	deleteOptions, err := options.deleteOptions()
+	if err != nil {
+		return err
+	}
 	gvr, err := k.resourceFor(gvk)
 	if err != nil {
 		return err
@@ -82,7 +239,172 @@ func (k *Kubernetes) ResourcesDelete(ctx context.Context, gvk *schema.GroupVersi
 	if namespaced, nsErr := k.isNamespaced(gvk); nsErr == nil && namespaced {
 		namespace = k.NamespaceOrDefault(namespace)
 	}
-	return k.manager.dynamicClient.Resource(*gvr).Namespace(namespace).Delete(ctx, name, metav1.DeleteOptions{})
+	return k.manager.dynamicClient.Resource(*gvr).Namespace(namespace).Delete(ctx, name, deleteOptions)
+}
+
+// ErrResourcesDeleteConfirmationMismatch is returned when the resources matching the selector are not the reviewed ones
+var ErrResourcesDeleteConfirmationMismatch = errors.New("the resources matching the selector changed since they were reviewed (confirmation token mismatch), nothing was deleted")
+
+// ResourcesDeleteBySelector lists the resources matching the label and field selectors in the provided namespace (never across all namespaces)
+// and returns their names along with a confirmation token derived from them.
+// The resources are only deleted if the provided confirmation token matches the token of the matching resources,
+// that is, if they are exactly the resources that were reviewed (ErrResourcesDeleteConfirmationMismatch otherwise).
+// Returns the names of the matching resources (no confirmation token) or the names of the deleted resources.
+func (k *Kubernetes) ResourcesDeleteBySelector(ctx context.Context, gvk *schema.GroupVersionKind, namespace string, listOptions metav1.ListOptions, options ResourcesDeleteOptions, confirmationToken string) ([]string, string, error) {
+	if listOptions.LabelSelector == "" && listOptions.FieldSelector == "" {
+		return nil, "", errors.New("a label or field selector is required")
+	}
+	deleteOptions, err := options.deleteOptions()
+	if err != nil {
+		return nil, "", err
+	}
+	gvr, err := k.resourceFor(gvk)
+	if err != nil {
+		return nil, "", err
+	}
+
+	// If it's a namespaced resource and namespace wasn't provided, try to use the default configured one
+	if namespaced, nsErr := k.isNamespaced(gvk); nsErr == nil && namespaced {
+		namespace = k.NamespaceOrDefault(namespace)
+	}
+	client := k.manager.dynamicClient.Resource(*gvr).Namespace(namespace)
+	list, err := client.List(ctx, listOptions)
+	if err != nil {
+		return nil, "", err
+	}
+	sort.Slice(list.Items, func(i, j int) bool { return list.Items[i].GetName() < list.Items[j].GetName() })
+	var names []string
+	// The token identifies the exact set of matching resources (name and UID), recreated resources produce a different token
+	hash := sha256.New()
+	_, _ = fmt.Fprintf(hash, "%s\n%s\n%s\n%s\n", gvr.String(), namespace, listOptions.LabelSelector, listOptions.FieldSelector)
+	for _, item := range list.Items {
+		names = append(names, item.GetName())
+		_, _ = fmt.Fprintf(hash, "%s/%s\n", item.GetName(), item.GetUID())
+	}
+	token := hex.EncodeToString(hash.Sum(nil))[:16]
+	if confirmationToken == "" || len(names) == 0 {
+		return names, token, nil
+	}
+	if confirmationToken != token {
+		return names, token, ErrResourcesDeleteConfirmationMismatch
+	}
+	// Only the reviewed resources are deleted (instead of a DeleteCollection), the UID precondition prevents deleting a recreated resource
+	var deleted []string
+	var errs []error
+	for _, item := range list.Items {
+		itemDeleteOptions := deleteOptions
+		if uid := item.GetUID(); uid != "" {
+			itemDeleteOptions.Preconditions = metav1.NewUIDPreconditions(string(uid))
+		}
+		if dErr := client.Delete(ctx, item.GetName(), itemDeleteOptions); dErr != nil && !apierrors.IsNotFound(dErr) {
+			errs = append(errs, fmt.Errorf("%s: %v", item.GetName(), dErr))
+			continue
+		}
+		deleted = append(deleted, item.GetName())
+	}
+	return deleted, token, utilerrors.NewAggregate(errs)
+}
+
+// ResourcesPatch patches the provided resource, or its subresource (e.g. status, scale) if provided,
+// with a json (RFC 6902), merge (RFC 7386), strategic merge, or server-side apply patch.
+// The patch can be provided either in JSON or YAML format.
+func (k *Kubernetes) ResourcesPatch(ctx context.Context, gvk *schema.GroupVersionKind, namespace, name, patchType, patch, subresource string) (*unstructured.Unstructured, error) {
+	pt, ok := resourcesPatchTypes[strings.ToLower(patchType)]
+	if !ok {
+		return nil, fmt.Errorf("invalid patch type %q, must be one of: json, merge, strategic, apply", patchType)
+	}
+	data, err := yml.YAMLToJSON([]byte(patch))
+	if err != nil {
+		return nil, fmt.Errorf("invalid patch: %v", err)
+	}
+	gvr, err := k.resourceFor(gvk)
+	if err != nil {
+		return nil, err
+	}
+
+	// If it's a namespaced resource and namespace wasn't provided, try to use the default configured one
+	if namespaced, nsErr := k.isNamespaced(gvk); nsErr == nil && namespaced {
+		namespace = k.NamespaceOrDefault(namespace)
+	}
+	var subresources []string
+	if subresource != "" {
+		subresources = append(subresources, subresource)
+	}
+	return k.manager.dynamicClient.Resource(*gvr).Namespace(namespace).Patch(ctx, name, pt, data, metav1.PatchOptions{
+		FieldManager: version.BinaryName,
+	}, subresources...)
+}
+
+// ResourcesScale reads or updates the scale subresource of the provided resource.
+// Only the spec.replicas field is patched, the rest of the resource and its field ownership are left untouched.
+func (k *Kubernetes) ResourcesScale(ctx context.Context, gvk *schema.GroupVersionKind, namespace, name string, options ResourcesScaleOptions) (*ResourcesScaleResult, error) {
+	gvr, err := k.resourceFor(gvk)
+	if err != nil {
+		return nil, err
+	}
+
+	// If it's a namespaced resource and namespace wasn't provided, try to use the default configured one
+	if namespaced, nsErr := k.isNamespaced(gvk); nsErr == nil && namespaced {
+		namespace = k.NamespaceOrDefault(namespace)
+	}
+	client := k.manager.dynamicClient.Resource(*gvr).Namespace(namespace)
+	scale, err := client.Get(ctx, name, metav1.GetOptions{}, "scale")
+	if err != nil {
+		return nil, err
+	}
+	result := &ResourcesScaleResult{APIVersion: gvk.GroupVersion().String(), Kind: gvk.Kind, Namespace: namespace, Name: name}
+	result.PreviousReplicas, _, _ = unstructured.NestedInt64(scale.Object, "spec", "replicas")
+	if options.Replicas != nil {
+		patch := fmt.Sprintf(`{"spec":{"replicas":%d}}`, *options.Replicas)
+		scale, err = client.Patch(ctx, name, types.MergePatchType, []byte(patch), metav1.PatchOptions{
+			FieldManager: version.BinaryName,
+		}, "scale")
+		if err != nil {
+			return nil, err
+		}
+	}
+	if err = k.resourcesScaleStatus(ctx, gvk, client, name, scale, result); err != nil {
+		return nil, err
+	}
+	if options.Timeout <= 0 {
+		return result, nil
+	}
+	err = wait.PollUntilContextTimeout(ctx, time.Second, options.Timeout, true, func(ctx context.Context) (bool, error) {
+		if scale, err = client.Get(ctx, name, metav1.GetOptions{}, "scale"); err != nil {
+			return false, err
+		}
+		if err = k.resourcesScaleStatus(ctx, gvk, client, name, scale, result); err != nil {
+			return false, err
+		}
+		return result.CurrentReplicas == result.Replicas && (result.ReadyReplicas == nil || *result.ReadyReplicas == result.Replicas), nil
+	})
+	switch {
+	case err == nil:
+		result.Message = fmt.Sprintf("%s %s has %d replicas ready", gvk.Kind, name, result.Replicas)
+	case wait.Interrupted(err):
+		result.Message = fmt.Sprintf("timed out after %s waiting for the replicas to converge", options.Timeout)
+	default:
+		return nil, err
+	}
+	return result, nil
+}
+
+// resourcesScaleStatus fills the result with the replicas from the scale subresource and,
+// if the resource reports them (Deployment, StatefulSet, ReplicaSet, most CRDs), its ready replicas.
+func (k *Kubernetes) resourcesScaleStatus(ctx context.Context, gvk *schema.GroupVersionKind, client dynamic.ResourceInterface, name string, scale *unstructured.Unstructured, result *ResourcesScaleResult) error {
+	result.Replicas, _, _ = unstructured.NestedInt64(scale.Object, "spec", "replicas")
+	result.CurrentReplicas, _, _ = unstructured.NestedInt64(scale.Object, "status", "replicas")
+	result.Selector, _, _ = unstructured.NestedString(scale.Object, "status", "selector")
+	obj, err := client.Get(ctx, name, metav1.GetOptions{})
+	if err != nil {
+		return err
+	}
+	result.ReadyReplicas = nil
+	// apps/v1 workloads omit readyReplicas when there are none
+	if readyReplicas, found, _ := unstructured.NestedInt64(obj.Object, "status", "readyReplicas"); found || gvk.Group == "apps" {
+		result.ReadyReplicas = &readyReplicas
+	}
+	return nil
 }
 
 // resourcesListAsTable retrieves a list of resources in a table format.
@@ -133,6 +455,18 @@ func (k *Kubernetes) resourcesListAsTable(ctx context.Context, gvk *schema.Group
 }
 
 func (k *Kubernetes) resourcesCreateOrUpdate(ctx context.Context, resources []*unstructured.Unstructured) ([]*unstructured.Unstructured, error) {
+	return k.resourcesApply(ctx, resources, ResourcesCreateOrUpdateOptions{})
+}
+
+// resourcesApply server-side applies the provided resources, if DryRun is set the changes are validated by the server but not persisted
+func (k *Kubernetes) resourcesApply(ctx context.Context, resources []*unstructured.Unstructured, options ResourcesCreateOrUpdateOptions) ([]*unstructured.Unstructured, error) {
+	applyOptions := metav1.ApplyOptions{FieldManager: version.BinaryName, Force: options.Force}
+	if options.FieldManager != "" {
+		applyOptions.FieldManager = options.FieldManager
+	}
+	if options.DryRun {
+		applyOptions.DryRun = []string{metav1.DryRunAll}
+	}
 	for i, obj := range resources {
 		gvk := obj.GroupVersionKind()
 		gvr, rErr := k.resourceFor(&gvk)
@@ -145,20 +479,107 @@ func (k *Kubernetes) resourcesCreateOrUpdate(ctx context.Context, resources []*u
 		if namespaced, nsErr := k.isNamespaced(&gvk); nsErr == nil && namespaced {
 			namespace = k.NamespaceOrDefault(namespace)
 		}
-		resources[i], rErr = k.manager.dynamicClient.Resource(*gvr).Namespace(namespace).Apply(ctx, obj.GetName(), obj, metav1.ApplyOptions{
-			FieldManager: version.BinaryName,
-		})
-		if rErr != nil {
+		resources[i], rErr = k.manager.dynamicClient.Resource(*gvr).Namespace(namespace).Apply(ctx, obj.GetName(), obj, applyOptions)
+		if conflictErr := resourcesApplyConflictError(obj, namespace, rErr); conflictErr != nil {
+			return nil, conflictErr
+		} else if rErr != nil {
 			return nil, rErr
 		}
 		// Clear the cache to ensure the next operation is performed on the latest exposed APIs (will change after the CRD creation)
-		if gvk.Kind == "CustomResourceDefinition" {
+		if gvk.Kind == "CustomResourceDefinition" && !options.DryRun {
 			k.manager.accessControlRESTMapper.Reset()
 		}
 	}
 	return resources, nil
 }
 
+// resourcesApplyConflictError parses the field manager conflicts reported by the server into a ResourcesApplyConflictError (nil if err is not an apply conflict)
+// https://github.com/kubernetes/apimachinery/blob/v0.33.3/pkg/util/managedfields/internal/conflict.go#L32-L49
+func resourcesApplyConflictError(obj *unstructured.Unstructured, namespace string, err error) *ResourcesApplyConflictError {
+	var status apierrors.APIStatus
+	if !apierrors.IsConflict(err) || !errors.As(err, &status) || status.Status().Details == nil {
+		return nil
+	}
+	conflictErr := &ResourcesApplyConflictError{APIVersion: obj.GetAPIVersion(), Kind: obj.GetKind(), Namespace: namespace, Name: obj.GetName()}
+	for _, cause := range status.Status().Details.Causes {
+		if cause.Type != metav1.CauseTypeFieldManagerConflict {
+			continue
+		}
+		conflict := ResourcesApplyConflict{Field: cause.Field, Manager: cause.Message, Message: cause.Message}
+		if m := resourcesApplyConflictManager.FindStringSubmatch(cause.Message); m != nil {
+			conflict.Manager, _ = strconv.Unquote(m[1])
+		}
+		conflictErr.Conflicts = append(conflictErr.Conflicts, conflict)
+	}
+	if len(conflictErr.Conflicts) == 0 {
+		return nil
+	}
+	return conflictErr
+}
+
+// parseResources parses the provided YAML or JSON representation of one or more resources (YAML documents separated by ---)
+func parseResources(resource string) ([]*unstructured.Unstructured, error) {
+	separator := regexp.MustCompile(`\r?\n---\r?\n`)
+	var parsedResources []*unstructured.Unstructured
+	for _, r := range separator.Split(resource, -1) {
+		var obj unstructured.Unstructured
+		if err := yaml.NewYAMLToJSONDecoder(strings.NewReader(r)).Decode(&obj); err != nil {
+			return nil, err
+		}
+		parsedResources = append(parsedResources, &obj)
+	}
+	return parsedResources, nil
+}
+
+// resourcesDiffYaml marshals the provided resource to YAML without the fields that are managed by the server
+// and change on every update (or don't provide any value when reviewing a change)
+func resourcesDiffYaml(obj *unstructured.Unstructured) (string, error) {
+	if obj == nil {
+		return "", nil
+	}
+	obj = obj.DeepCopy()
+	for _, field := range []string{"managedFields", "resourceVersion", "generation", "uid", "creationTimestamp", "selfLink"} {
+		unstructured.RemoveNestedField(obj.Object, "metadata", field)
+	}
+	unstructured.RemoveNestedField(obj.Object, "metadata", "annotations", "kubectl.kubernetes.io/last-applied-configuration")
+	if len(obj.GetAnnotations()) == 0 {
+		unstructured.RemoveNestedField(obj.Object, "metadata", "annotations")
+	}
+	ret, err := yml.Marshal(obj.Object)
+	if err != nil {
+		return "", err
+	}
+	return string(ret), nil
+}
+
+// resourcesDiffLines splits the provided YAML into lines keeping their line endings (difflib.SplitLines adds an extra empty line)
+func resourcesDiffLines(s string) []string {
+	if s == "" {
+		return nil
+	}
+	return strings.SplitAfter(strings.TrimSuffix(s, "\n"), "\n")
+}
+
+// ResourceGroupVersionKind resolves a kubectl-style resource name (e.g. deploy, svc, deployments.apps, certificates.cert-manager.io)
+// into its GroupVersionKind, using the preferred version of the group if none is provided (e.g. deployments.v1.apps).
+// Same resolution as the kubectl resource builder (short names, plural, singular, and group qualified names).
+func (k *Kubernetes) ResourceGroupVersionKind(resource string) (*schema.GroupVersionKind, error) {
+	mapper := restmapper.NewShortcutExpander(k.manager.accessControlRESTMapper, k.manager.discoveryClient, nil)
+	fullySpecifiedGVR, groupResource := schema.ParseResourceArg(strings.ToLower(resource))
+	if fullySpecifiedGVR != nil {
+		if gvk, err := mapper.KindFor(*fullySpecifiedGVR); err == nil {
+			return &gvk, nil
+		}
+	}
+	gvk, err := mapper.KindFor(groupResource.WithVersion(""))
+	if meta.IsNoMatchError(err) {
+		return nil, fmt.Errorf("the server doesn't have a resource type %q", resource)
+	} else if err != nil {
+		return nil, err
+	}
+	return &gvk, nil
+}
+
 func (k *Kubernetes) resourceFor(gvk *schema.GroupVersionKind) (*schema.GroupVersionResource, error) {
 	m, err := k.manager.accessControlRESTMapper.RESTMapping(schema.GroupKind{Group: gvk.Group, Kind: gvk.Kind}, gvk.Version)
 	if err != nil {
@@ -0,0 +1,176 @@
+package kubernetes
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"slices"
+	"sort"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/kube-openapi/pkg/spec3"
+	"k8s.io/kube-openapi/pkg/validation/spec"
+)
+
+type ResourcesExplainResult struct {
+	APIVersion string `json:"apiVersion"`
+	Kind       string `json:"kind"`
+	// Field path relative to the resource (e.g. spec.template.spec.containers), empty for the resource itself
+	Field string `json:"field,omitempty"`
+	Type  string `json:"type"`
+	// Required is true if the field is required by its parent
+	Required    bool                    `json:"required,omitempty"`
+	Description string                  `json:"description,omitempty"`
+	Fields      []ResourcesExplainField `json:"fields,omitempty"`
+}
+
+type ResourcesExplainField struct {
+	Name        string `json:"name"`
+	Type        string `json:"type"`
+	Required    bool   `json:"required,omitempty"`
+	Description string `json:"description,omitempty"`
+}
+
+// ResourcesExplain describes the provided resource, or one of its fields (e.g. spec.template.spec.containers.livenessProbe),
+// and its child fields, using the OpenAPI v3 schema published by the cluster (includes CRD schemas)
+func (k *Kubernetes) ResourcesExplain(_ context.Context, gvk *schema.GroupVersionKind, field string) (*ResourcesExplainResult, error) {
+	// Access control check, denied resources can't be explained either
+	if _, err := k.resourceFor(gvk); err != nil {
+		return nil, err
+	}
+	paths, err := k.manager.discoveryClient.OpenAPIV3().Paths()
+	if err != nil {
+		return nil, err
+	}
+	path := "apis/" + gvk.GroupVersion().String()
+	if gvk.Group == "" {
+		path = "api/" + gvk.Version
+	}
+	gv, ok := paths[path]
+	if !ok {
+		return nil, fmt.Errorf("no OpenAPI v3 schema published for %s", gvk.GroupVersion().String())
+	}
+	data, err := gv.Schema(runtime.ContentTypeJSON)
+	if err != nil {
+		return nil, err
+	}
+	doc := &spec3.OpenAPI{}
+	if err = json.Unmarshal(data, doc); err != nil {
+		return nil, err
+	}
+	if doc.Components == nil {
+		return nil, fmt.Errorf("no OpenAPI v3 schema published for %s", gvk.String())
+	}
+	e := &resourcesExplainer{schemas: doc.Components.Schemas}
+	current := e.schemaFor(gvk)
+	if current == nil {
+		return nil, fmt.Errorf("no OpenAPI v3 schema published for %s", gvk.String())
+	}
+	result := &ResourcesExplainResult{APIVersion: gvk.GroupVersion().String(), Kind: gvk.Kind, Field: strings.Trim(field, ".")}
+	if result.Field != "" {
+		segments := strings.Split(result.Field, ".")
+		for i, name := range segments {
+			parent := e.element(current)
+			property, ok := parent.Properties[name]
+			if !ok {
+				return nil, fmt.Errorf("field %q does not exist in %s", strings.Join(segments[:i+1], "."), gvk.Kind)
+			}
+			result.Required = slices.Contains(parent.Required, name)
+			current = &property
+		}
+	}
+	result.Type = e.typeName(current)
+	result.Description = e.description(current)
+	element := e.element(current)
+	for name, property := range element.Properties {
+		result.Fields = append(result.Fields, ResourcesExplainField{
+			Name:        name,
+			Type:        e.typeName(&property),
+			Required:    slices.Contains(element.Required, name),
+			Description: e.description(&property),
+		})
+	}
+	sort.Slice(result.Fields, func(i, j int) bool { return result.Fields[i].Name < result.Fields[j].Name })
+	return result, nil
+}
+
+type resourcesExplainer struct {
+	schemas map[string]*spec.Schema
+}
+
+// schemaFor finds the schema of the provided GroupVersionKind (x-kubernetes-group-version-kind extension)
+func (e *resourcesExplainer) schemaFor(gvk *schema.GroupVersionKind) *spec.Schema {
+	for _, s := range e.schemas {
+		gvks, _ := s.Extensions["x-kubernetes-group-version-kind"].([]interface{})
+		for _, g := range gvks {
+			if m, ok := g.(map[string]interface{}); ok && m["group"] == gvk.Group && m["version"] == gvk.Version && m["kind"] == gvk.Kind {
+				return s
+			}
+		}
+	}
+	return nil
+}
+
+// resolve follows the schema references ($ref, or allOf with a single $ref as published by the OpenAPI v3 endpoint)
+func (e *resourcesExplainer) resolve(s *spec.Schema) *spec.Schema {
+	for range 10 {
+		if len(s.AllOf) == 1 {
+			s = &s.AllOf[0]
+		}
+		ref := s.Ref.String()
+		if ref == "" {
+			return s
+		}
+		resolved, ok := e.schemas[strings.TrimPrefix(ref, "#/components/schemas/")]
+		if !ok {
+			return s
+		}
+		s = resolved
+	}
+	return s
+}
+
+// element resolves the schema of the object that contains the fields (the items of an array or the values of a map)
+func (e *resourcesExplainer) element(s *spec.Schema) *spec.Schema {
+	s = e.resolve(s)
+	switch {
+	case s.Items != nil && s.Items.Schema != nil:
+		return e.element(s.Items.Schema)
+	case len(s.Properties) == 0 && s.AdditionalProperties != nil && s.AdditionalProperties.Schema != nil:
+		return e.element(s.AdditionalProperties.Schema)
+	}
+	return s
+}
+
+func (e *resourcesExplainer) description(s *spec.Schema) string {
+	// The description of the field takes precedence over the description of the referenced type
+	if s.Description != "" {
+		return s.Description
+	}
+	return e.resolve(s).Description
+}
+
+// typeName returns a kubectl explain like type name (e.g. string, []Container, map[string]string, Object)
+func (e *resourcesExplainer) typeName(s *spec.Schema) string {
+	ref := s.Ref.String()
+	if len(s.AllOf) == 1 {
+		ref = s.AllOf[0].Ref.String()
+	}
+	resolved := e.resolve(s)
+	switch {
+	case resolved.Extensions["x-kubernetes-int-or-string"] == true || resolved.Format == "int-or-string":
+		return "IntOrString"
+	case resolved.Type.Contains("array") && resolved.Items != nil && resolved.Items.Schema != nil:
+		return "[]" + e.typeName(resolved.Items.Schema)
+	case resolved.Type.Contains("object") && len(resolved.Properties) == 0 && resolved.AdditionalProperties != nil && resolved.AdditionalProperties.Schema != nil:
+		return "map[string]" + e.typeName(resolved.AdditionalProperties.Schema)
+	case ref != "" && (len(resolved.Type) == 0 || resolved.Type.Contains("object")):
+		name := strings.TrimPrefix(ref, "#/components/schemas/")
+		return name[strings.LastIndex(name, ".")+1:]
+	case len(resolved.Type) > 0:
+		return resolved.Type[0]
+	}
+	return "Object"
+}
@@ -0,0 +1,279 @@
+package kubernetes
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"sort"
+	"strings"
+	"sync"
+
+	"golang.org/x/sync/errgroup"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/discovery"
+)
+
+// resourcesTreeMaxDepth prevents infinite loops in case of cyclic ownerReferences
+const resourcesTreeMaxDepth = 20
+
+type ResourcesTreeNode struct {
+	APIVersion string `json:"apiVersion"`
+	Kind       string `json:"kind"`
+	Namespace  string `json:"namespace,omitempty"`
+	Name       string `json:"name"`
+	UID        string `json:"uid,omitempty"`
+	// Status summarizes the readiness of the resource (e.g. Ready, NotReady: CrashLoopBackOff, Available, Ready 2/3, Running)
+	Status string `json:"status,omitempty"`
+	// Requested is true for the resource the tree was requested for
+	Requested bool                 `json:"requested,omitempty"`
+	Children  []*ResourcesTreeNode `json:"children,omitempty"`
+}
+
+// resourcesTreeDependent is a resource with ownerReferences, only its metadata is listed for cluster-scoped owners
+// (the complete resource is retrieved once it's found to be a dependent of the tree)
+type resourcesTreeDependent struct {
+	gvr          schema.GroupVersionResource
+	obj          *unstructured.Unstructured
+	metadataOnly bool
+}
+
+// ResourcesTree returns the ownership tree of the provided resource (kubectl tree equivalent).
+// The ownerReferences are followed upwards to find the top-level owner, then the dependents of the top-level owner
+// are discovered downwards across all the listable resources.
+// Returns the resources that couldn't be listed (e.g. forbidden) too, in which case the tree may be incomplete.
+func (k *Kubernetes) ResourcesTree(ctx context.Context, gvk *schema.GroupVersionKind, namespace, name string) (*ResourcesTreeNode, []string, error) {
+	obj, err := k.ResourcesGet(ctx, gvk, namespace, name)
+	if err != nil {
+		return nil, nil, err
+	}
+	requested := string(obj.GetUID())
+	root := resourcesTreeNodeFor(obj)
+	// Upwards, follow the controller (or first) ownerReference until the top-level owner is found
+	for range resourcesTreeMaxDepth {
+		owner := resourcesTreeOwner(obj)
+		if owner == nil {
+			break
+		}
+		ownerGv, _ := schema.ParseGroupVersion(owner.APIVersion)
+		ownerGvk := &schema.GroupVersionKind{Group: ownerGv.Group, Version: ownerGv.Version, Kind: owner.Kind}
+		// Namespaced resources can be owned by cluster-scoped resources, but not the other way around
+		ownerNamespace := obj.GetNamespace()
+		if namespaced, nsErr := k.isNamespaced(ownerGvk); nsErr == nil && !namespaced {
+			ownerNamespace = ""
+		}
+		ownerNode := &ResourcesTreeNode{APIVersion: owner.APIVersion, Kind: owner.Kind, Namespace: ownerNamespace, Name: owner.Name, UID: string(owner.UID)}
+		obj, err = k.ResourcesGet(ctx, ownerGvk, ownerNamespace, owner.Name)
+		if err != nil {
+			// The owner can't be retrieved (e.g. deleted or not allowed), the tree is rooted at the ownerReference
+			ownerNode.Status = "Unknown: " + err.Error()
+			root = ownerNode
+			break
+		}
+		root = resourcesTreeNodeFor(obj)
+	}
+	// Downwards, index the listable resources by owner UID
+	dependents, failed, err := k.resourcesTreeDependents(ctx, root.Namespace)
+	if err != nil {
+		return nil, nil, err
+	}
+	visited := map[string]bool{}
+	var addChildren func(node *ResourcesTreeNode, depth int)
+	addChildren = func(node *ResourcesTreeNode, depth int) {
+		if visited[node.UID] || depth > resourcesTreeMaxDepth {
+			return
+		}
+		visited[node.UID] = true
+		for _, dependent := range dependents[types.UID(node.UID)] {
+			child := resourcesTreeNodeFor(dependent.obj)
+			if dependent.metadataOnly {
+				full, getErr := k.manager.dynamicClient.Resource(dependent.gvr).Namespace(dependent.obj.GetNamespace()).
+					Get(ctx, dependent.obj.GetName(), metav1.GetOptions{})
+				if getErr != nil {
+					child.Status = "Unknown: " + getErr.Error()
+				} else {
+					child = resourcesTreeNodeFor(full)
+				}
+			}
+			child.Requested = child.UID == requested
+			addChildren(child, depth+1)
+			node.Children = append(node.Children, child)
+		}
+		sort.Slice(node.Children, func(i, j int) bool {
+			if node.Children[i].Kind != node.Children[j].Kind {
+				return node.Children[i].Kind < node.Children[j].Kind
+			}
+			return node.Children[i].Name < node.Children[j].Name
+		})
+	}
+	root.Requested = root.UID == requested
+	addChildren(root, 0)
+	if err = ctx.Err(); err != nil {
+		return nil, nil, err
+	}
+	return root, failed, nil
+}
+
+// resourcesTreeDependents lists all the listable (and allowed) resources in the provided namespace,
+// returning the ones with ownerReferences indexed by owner UID, and the resources that couldn't be listed.
+// If namespace is empty (cluster-scoped owner), the cluster-scoped resources and the namespaced resources in all namespaces are listed,
+// only their metadata is listed to prevent retrieving the complete contents of the cluster.
+func (k *Kubernetes) resourcesTreeDependents(ctx context.Context, namespace string) (map[types.UID][]*resourcesTreeDependent, []string, error) {
+	lists, err := k.manager.discoveryClient.ServerPreferredResources()
+	if err != nil && !discovery.IsGroupDiscoveryFailedError(err) {
+		return nil, nil, err
+	}
+	var mu sync.Mutex
+	dependents := map[types.UID][]*resourcesTreeDependent{}
+	var failed []string
+	tasks, tasksCtx := errgroup.WithContext(ctx)
+	tasks.SetLimit(10)
+	for _, list := range lists {
+		gv, gvErr := schema.ParseGroupVersion(list.GroupVersion)
+		if gvErr != nil {
+			continue
+		}
+		for _, r := range list.APIResources {
+			// Subresources and Events (never owned, but usually the largest lists) are skipped
+			if strings.Contains(r.Name, "/") || r.Kind == "Event" || !slices.Contains(r.Verbs, "list") {
+				continue
+			}
+			// Cluster-scoped resources can't be owned by namespaced resources
+			if !r.Namespaced && namespace != "" {
+				continue
+			}
+			if !isAllowed(k.manager.staticConfig, &schema.GroupVersionKind{Group: gv.Group, Version: gv.Version, Kind: r.Kind}) {
+				continue
+			}
+			gvr := gv.WithResource(r.Name)
+			ns := ""
+			if r.Namespaced {
+				ns = namespace
+			}
+			kind := r.Kind
+			tasks.Go(func() error {
+				items, listErr := k.resourcesTreeList(tasksCtx, gvr, kind, ns)
+				mu.Lock()
+				defer mu.Unlock()
+				// Resources that can't be listed (e.g. forbidden) are reported, the rest of the tree is still discovered
+				if listErr != nil {
+					failed = append(failed, fmt.Sprintf("%s: %v", gvr.GroupResource().String(), listErr))
+					return nil
+				}
+				for _, item := range items {
+					for _, owner := range item.obj.GetOwnerReferences() {
+						dependents[owner.UID] = append(dependents[owner.UID], item)
+					}
+				}
+				return nil
+			})
+		}
+	}
+	_ = tasks.Wait()
+	if err = ctx.Err(); err != nil {
+		return nil, nil, err
+	}
+	sort.Strings(failed)
+	return dependents, failed, nil
+}
+
+// resourcesTreeList lists the resources of the provided kind with ownerReferences, only their metadata if namespace is empty (all namespaces)
+func (k *Kubernetes) resourcesTreeList(ctx context.Context, gvr schema.GroupVersionResource, kind, namespace string) ([]*resourcesTreeDependent, error) {
+	var ret []*resourcesTreeDependent
+	if namespace != "" {
+		list, err := k.manager.dynamicClient.Resource(gvr).Namespace(namespace).List(ctx, metav1.ListOptions{})
+		if err != nil {
+			return nil, err
+		}
+		for i := range list.Items {
+			if len(list.Items[i].GetOwnerReferences()) > 0 {
+				ret = append(ret, &resourcesTreeDependent{gvr: gvr, obj: &list.Items[i]})
+			}
+		}
+		return ret, nil
+	}
+	list, err := k.manager.metadataClient.Resource(gvr).List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return nil, err
+	}
+	for i := range list.Items {
+		if len(list.Items[i].OwnerReferences) == 0 {
+			continue
+		}
+		obj := &unstructured.Unstructured{}
+		obj.SetAPIVersion(gvr.GroupVersion().String())
+		obj.SetKind(kind)
+		obj.SetNamespace(list.Items[i].Namespace)
+		obj.SetName(list.Items[i].Name)
+		obj.SetUID(list.Items[i].UID)
+		obj.SetOwnerReferences(list.Items[i].OwnerReferences)
+		ret = append(ret, &resourcesTreeDependent{gvr: gvr, obj: obj, metadataOnly: true})
+	}
+	return ret, nil
+}
+
+// resourcesTreeOwner returns the controller ownerReference, or the first ownerReference if none is the controller
+func resourcesTreeOwner(obj *unstructured.Unstructured) *metav1.OwnerReference {
+	owners := obj.GetOwnerReferences()
+	if len(owners) == 0 {
+		return nil
+	}
+	for i := range owners {
+		if owners[i].Controller != nil && *owners[i].Controller {
+			return &owners[i]
+		}
+	}
+	return &owners[0]
+}
+
+func resourcesTreeNodeFor(obj *unstructured.Unstructured) *ResourcesTreeNode {
+	return &ResourcesTreeNode{
+		APIVersion: obj.GetAPIVersion(),
+		Kind:       obj.GetKind(),
+		Namespace:  obj.GetNamespace(),
+		Name:       obj.GetName(),
+		UID:        string(obj.GetUID()),
+		Status:     resourcesTreeStatus(obj),
+	}
+}
+
+// resourcesTreeStatus summarizes the readiness of the resource from its Ready or Available conditions,
+// its ready replicas (workloads), or its phase
+func resourcesTreeStatus(obj *unstructured.Unstructured) string {
+	conditions, _, _ := unstructured.NestedSlice(obj.Object, "status", "conditions")
+	for _, conditionType := range []string{"Ready", "Available"} {
+		for _, c := range conditions {
+			condition, ok := c.(map[string]interface{})
+			if !ok || condition["type"] != conditionType {
+				continue
+			}
+			if condition["status"] == string(metav1.ConditionTrue) {
+				return conditionType
+			}
+			status := "Not" + conditionType
+			if reason, _ := condition["reason"].(string); reason != "" {
+				status += ": " + reason
+			}
+			return status
+		}
+	}
+	// ReplicaSet and StatefulSet (readyReplicas), DaemonSet (numberReady)
+	desired, found, _ := unstructured.NestedInt64(obj.Object, "status", "replicas")
+	if !found {
+		desired, found, _ = unstructured.NestedInt64(obj.Object, "status", "desiredNumberScheduled")
+	}
+	if found {
+		ready, readyFound, _ := unstructured.NestedInt64(obj.Object, "status", "readyReplicas")
+		if !readyFound {
+			ready, _, _ = unstructured.NestedInt64(obj.Object, "status", "numberReady")
+		}
+		if ready >= desired {
+			return fmt.Sprintf("Ready %d/%d", ready, desired)
+		}
+		return fmt.Sprintf("NotReady %d/%d", ready, desired)
+	}
+	phase, _, _ := unstructured.NestedString(obj.Object, "status", "phase")
+	return phase
+}
@@ -0,0 +1,201 @@
+package kubernetes
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/apimachinery/pkg/watch"
+	"k8s.io/client-go/tools/cache"
+	toolswatch "k8s.io/client-go/tools/watch"
+	"k8s.io/client-go/util/jsonpath"
+)
+
+type ResourcesWaitOptions struct {
+	// Name of the resource to wait for (all the resources matching the LabelSelector if not provided)
+	Name          string
+	LabelSelector string
+	// For is the condition to wait for, same format as kubectl wait --for:
+	// delete, condition=Type, condition=Type=Value, jsonpath={.path}, jsonpath={.path}=value
+	For     string
+	Timeout time.Duration
+	// OnProgress is called when the wait starts and every time a resource meets the condition
+	OnProgress func(met, total int, message string)
+}
+
+type ResourcesWaitResult struct {
+	// Met lists the resources that met the condition
+	Met []string `json:"met,omitempty"`
+	// TimedOut lists the resources that didn't meet the condition before the timeout
+	TimedOut []string `json:"timedOut,omitempty"`
+	// Failed lists the resources that can no longer meet the condition (e.g. deleted while waiting for a condition)
+	Failed []string `json:"failed,omitempty"`
+}
+
+// resourcesWaitCondition returns true if the resource meets the condition
+type resourcesWaitCondition func(obj *unstructured.Unstructured) bool
+
+// ResourcesWait watches the provided resource, or every resource matching the label selector, until they meet the condition or the timeout expires
+// https://github.com/kubernetes/kubectl/blob/5366de04e168bcbc11f5e340d131a9ca8b7d0df4/pkg/cmd/wait/wait.go
+func (k *Kubernetes) ResourcesWait(ctx context.Context, gvk *schema.GroupVersionKind, namespace string, options ResourcesWaitOptions) (*ResourcesWaitResult, error) {
+	condition, err := parseResourcesWaitFor(options.For)
+	if err != nil {
+		return nil, err
+	}
+	deletion := strings.EqualFold(options.For, "delete")
+	gvr, err := k.resourceFor(gvk)
+	if err != nil {
+		return nil, err
+	}
+
+	// If it's a namespaced resource and namespace wasn't provided, try to use the default configured one
+	if namespaced, nsErr := k.isNamespaced(gvk); nsErr == nil && namespaced {
+		namespace = k.NamespaceOrDefault(namespace)
+	}
+	client := k.manager.dynamicClient.Resource(*gvr).Namespace(namespace)
+	listOptions := metav1.ListOptions{LabelSelector: options.LabelSelector}
+	if options.Name != "" {
+		listOptions = metav1.ListOptions{FieldSelector: "metadata.name=" + options.Name}
+	}
+	list, err := client.List(ctx, listOptions)
+	if err != nil {
+		return nil, err
+	}
+	result := &ResourcesWaitResult{}
+	// pending resources that haven't met the condition yet
+	var pending []string
+	for _, item := range list.Items {
+		if condition(&item) {
+			result.Met = append(result.Met, item.GetName())
+		} else {
+			pending = append(pending, item.GetName())
+		}
+	}
+	if options.Name != "" && len(list.Items) == 0 {
+		if deletion {
+			result.Met = append(result.Met, options.Name)
+		} else {
+			// Wait for the resource to be created
+			pending = append(pending, options.Name)
+		}
+	}
+	if len(result.Met) == 0 && len(pending) == 0 {
+		if deletion {
+			return result, nil
+		}
+		return nil, errors.New("no matching resources found")
+	}
+	total := len(result.Met) + len(pending)
+	progress := func(message string) {
+		if options.OnProgress != nil {
+			options.OnProgress(len(result.Met), total, message)
+		}
+	}
+	progress(fmt.Sprintf("waiting for %d of %d %s to meet the condition %s", len(pending), total, gvr.Resource, options.For))
+	if len(pending) == 0 {
+		return result, nil
+	}
+	watchCtx, cancel := context.WithTimeout(ctx, options.Timeout)
+	defer cancel()
+	// The watch is restarted from the last observed resourceVersion if the server closes it before the timeout
+	watcher := &cache.ListWatch{WatchFunc: func(o metav1.ListOptions) (watch.Interface, error) {
+		o.LabelSelector, o.FieldSelector = listOptions.LabelSelector, listOptions.FieldSelector
+		return client.Watch(watchCtx, o)
+	}}
+	_, err = toolswatch.Until(watchCtx, list.GetResourceVersion(), watcher, func(event watch.Event) (bool, error) {
+		obj, ok := event.Object.(*unstructured.Unstructured)
+		if !ok {
+			if event.Type == watch.Error {
+				return false, apierrors.FromObject(event.Object)
+			}
+			return false, nil
+		}
+		if !slices.Contains(pending, obj.GetName()) {
+			return false, nil
+		}
+		switch {
+		case event.Type == watch.Deleted && !deletion:
+			result.Failed = append(result.Failed, fmt.Sprintf("%s was deleted", obj.GetName()))
+		case event.Type == watch.Deleted || condition(obj):
+			result.Met = append(result.Met, obj.GetName())
+			progress(fmt.Sprintf("%s met the condition %s", obj.GetName(), options.For))
+		default:
+			return false, nil
+		}
+		pending = slices.DeleteFunc(pending, func(name string) bool { return name == obj.GetName() })
+		return len(pending) == 0, nil
+	})
+	if err != nil && !wait.Interrupted(err) && !errors.Is(err, toolswatch.ErrWatchClosed) {
+		return nil, err
+	}
+	result.TimedOut = pending
+	return result, nil
+}
+
+// parseResourcesWaitFor parses the kubectl wait --for format into a resourcesWaitCondition
+func parseResourcesWaitFor(waitFor string) (resourcesWaitCondition, error) {
+	switch {
+	case strings.EqualFold(waitFor, "delete"):
+		// Deletion is only met by the Deleted watch events
+		return func(_ *unstructured.Unstructured) bool { return false }, nil
+	case strings.HasPrefix(waitFor, "condition="):
+		conditionType, conditionStatus, _ := strings.Cut(strings.TrimPrefix(waitFor, "condition="), "=")
+		if conditionType == "" {
+			return nil, fmt.Errorf("invalid condition %q", waitFor)
+		}
+		if conditionStatus == "" {
+			conditionStatus = "True"
+		}
+		return func(obj *unstructured.Unstructured) bool {
+			conditions, _, _ := unstructured.NestedSlice(obj.Object, "status", "conditions")
+			for _, c := range conditions {
+				condition, ok := c.(map[string]interface{})
+				if !ok || !strings.EqualFold(fmt.Sprint(condition["type"]), conditionType) {
+					continue
+				}
+				// Conditions reported for a previous generation of the resource are ignored
+				if observedGeneration, found, _ := unstructured.NestedInt64(condition, "observedGeneration"); found && observedGeneration < obj.GetGeneration() {
+					return false
+				}
+				return strings.EqualFold(fmt.Sprint(condition["status"]), conditionStatus)
+			}
+			return false
+		}, nil
+	case strings.HasPrefix(waitFor, "jsonpath="):
+		expression, value := strings.TrimPrefix(waitFor, "jsonpath="), ""
+		if i := strings.LastIndex(expression, "}="); i >= 0 {
+			expression, value = expression[:i+1], expression[i+2:]
+		}
+		j := jsonpath.New("wait").AllowMissingKeys(true)
+		if err := j.Parse(expression); err != nil {
+			return nil, fmt.Errorf("invalid jsonpath %q: %v", expression, err)
+		}
+		return func(obj *unstructured.Unstructured) bool {
+			results, err := j.FindResults(obj.Object)
+			if err != nil {
+				return false
+			}
+			for _, r := range results {
+				for _, v := range r {
+					if !v.IsValid() || (v.CanInterface() && v.Interface() == nil) {
+						continue
+					}
+					// Without value, the condition is met if the path exists
+					if value == "" || fmt.Sprint(v.Interface()) == value {
+						return true
+					}
+				}
+			}
+			return false
+		}, nil
+	}
+	return nil, fmt.Errorf("invalid condition %q, must be one of: delete, condition=Type, condition=Type=Value, jsonpath={.path}, jsonpath={.path}=value", waitFor)
+}
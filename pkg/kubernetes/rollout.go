@@ -0,0 +1,231 @@
+package kubernetes
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/apimachinery/pkg/watch"
+	"k8s.io/client-go/kubernetes"
+	appsv1 "k8s.io/client-go/kubernetes/typed/apps/v1"
+	toolswatch "k8s.io/client-go/tools/watch"
+	cmdutil "k8s.io/kubectl/pkg/cmd/util"
+	"k8s.io/kubectl/pkg/polymorphichelpers"
+
+	"github.com/manusa/kubernetes-mcp-server/pkg/version"
+)
+
+type RolloutStatusOptions struct {
+	Kind string
+	Name string
+	// Revision to check the status for (latest if 0), only supported for StatefulSets
+	Revision int64
+	// Timeout to wait until the rollout completes (no wait if 0)
+	Timeout time.Duration
+}
+
+// rolloutKinds maps the lowercase kind of the workloads that support rollouts to their GroupVersionKind
+var rolloutKinds = map[string]*schema.GroupVersionKind{
+	"deployment":  workloadKinds["deployment"],
+	"statefulset": workloadKinds["statefulset"],
+	"daemonset":   workloadKinds["daemonset"],
+}
+
+func rolloutKindFor(kind string) (*schema.GroupVersionKind, error) {
+	gvk, ok := rolloutKinds[strings.ToLower(kind)]
+	if !ok {
+		return nil, fmt.Errorf("unsupported rollout kind %q (supported: Deployment, StatefulSet, DaemonSet)", kind)
+	}
+	return gvk, nil
+}
+
+// RolloutStatus returns the rollout status message of the workload and whether the rollout is complete
+// https://github.com/kubernetes/kubectl/blob/5366de04e168bcbc11f5e340d131a9ca8b7d0df4/pkg/cmd/rollout/rollout_status.go#L178-L245
+func (k *Kubernetes) RolloutStatus(ctx context.Context, namespace string, options RolloutStatusOptions) (string, bool, error) {
+	gvk, err := rolloutKindFor(options.Kind)
+	if err != nil {
+		return "", false, err
+	}
+	statusViewer, err := polymorphichelpers.StatusViewerFor(gvk.GroupKind())
+	if err != nil {
+		return "", false, err
+	}
+	workload, err := k.ResourcesGet(ctx, gvk, namespace, options.Name)
+	if err != nil {
+		return "", false, err
+	}
+	status, done, err := statusViewer.Status(workload, options.Revision)
+	if err != nil || done || options.Timeout <= 0 {
+		return status, done, err
+	}
+	gvr, err := k.resourceFor(gvk)
+	if err != nil {
+		return "", false, err
+	}
+	watchCtx, cancel := context.WithTimeout(ctx, options.Timeout)
+	defer cancel()
+	watcher, err := k.manager.dynamicClient.Resource(*gvr).Namespace(workload.GetNamespace()).Watch(watchCtx, metav1.ListOptions{
+		FieldSelector:   "metadata.name=" + options.Name,
+		ResourceVersion: workload.GetResourceVersion(),
+	})
+	if err != nil {
+		return "", false, err
+	}
+	_, err = toolswatch.UntilWithoutRetry(watchCtx, watcher, func(event watch.Event) (bool, error) {
+		if event.Type == watch.Deleted {
+			return false, fmt.Errorf("%s %s was deleted", gvk.Kind, options.Name)
+		}
+		obj, ok := event.Object.(*unstructured.Unstructured)
+		if !ok {
+			return false, nil
+		}
+		status, done, err = statusViewer.Status(obj, options.Revision)
+		return done, err
+	})
+	if err != nil && (wait.Interrupted(err) || errors.Is(err, toolswatch.ErrWatchClosed)) {
+		return fmt.Sprintf("%stimed out after %s waiting for the rollout to finish", status, options.Timeout), false, nil
+	}
+	return status, done, err
+}
+
+// RolloutHistory returns the revisions of the workload, or the details of the provided revision
+func (k *Kubernetes) RolloutHistory(ctx context.Context, namespace, kind, name string, revision int64) (string, error) {
+	gvk, err := rolloutKindFor(kind)
+	if err != nil {
+		return "", err
+	}
+	clientset, err := k.rolloutClientset(gvk, k.NamespaceOrDefault(namespace))
+	if err != nil {
+		return "", err
+	}
+	workload, err := k.ResourcesGet(ctx, gvk, namespace, name)
+	if err != nil {
+		return "", err
+	}
+	historyViewer, err := polymorphichelpers.HistoryViewerFor(gvk.GroupKind(), clientset)
+	if err != nil {
+		return "", err
+	}
+	return historyViewer.ViewHistory(workload.GetNamespace(), workload.GetName(), revision)
+}
+
+// RolloutUndo rolls back the workload to the provided revision (previous revision if 0)
+func (k *Kubernetes) RolloutUndo(ctx context.Context, namespace, kind, name string, toRevision int64) (string, error) {
+	gvk, err := rolloutKindFor(kind)
+	if err != nil {
+		return "", err
+	}
+	clientset, err := k.rolloutClientset(gvk, k.NamespaceOrDefault(namespace))
+	if err != nil {
+		return "", err
+	}
+	workload, err := k.ResourcesGet(ctx, gvk, namespace, name)
+	if err != nil {
+		return "", err
+	}
+	rollbacker, err := polymorphichelpers.RollbackerFor(gvk.GroupKind(), clientset)
+	if err != nil {
+		return "", err
+	}
+	return rollbacker.Rollback(workload, nil, toRevision, cmdutil.DryRunNone)
+}
+
+// RolloutRestart triggers a rolling restart of the workload by updating the restartedAt annotation of its Pod template
+// https://github.com/kubernetes/kubectl/blob/5366de04e168bcbc11f5e340d131a9ca8b7d0df4/pkg/polymorphichelpers/objectrestarter.go#L32-L119
+func (k *Kubernetes) RolloutRestart(ctx context.Context, namespace, kind, name string) (*unstructured.Unstructured, error) {
+	gvk, err := rolloutKindFor(kind)
+	if err != nil {
+		return nil, err
+	}
+	workload, err := k.ResourcesGet(ctx, gvk, namespace, name)
+	if err != nil {
+		return nil, err
+	}
+	if paused, _, _ := unstructured.NestedBool(workload.Object, "spec", "paused"); paused {
+		return nil, fmt.Errorf("can't restart paused %s %s (resume it first)", gvk.Kind, name)
+	}
+	gvr, err := k.resourceFor(gvk)
+	if err != nil {
+		return nil, err
+	}
+	patch := fmt.Sprintf(`{"spec":{"template":{"metadata":{"annotations":{"kubectl.kubernetes.io/restartedAt":%q}}}}}`, time.Now().Format(time.RFC3339))
+	return k.manager.dynamicClient.Resource(*gvr).Namespace(workload.GetNamespace()).
+		Patch(ctx, name, types.StrategicMergePatchType, []byte(patch), metav1.PatchOptions{FieldManager: version.BinaryName})
+}
+
+// rolloutClientset returns the clientset for the kubectl rollout helpers (polymorphichelpers) in the workload namespace.
+// Only the access-controlled apps/v1 clients of the workload kind and its revision kind (ReplicaSet or ControllerRevision)
+// are available, the helpers don't use any other client.
+func (k *Kubernetes) rolloutClientset(gvk *schema.GroupVersionKind, namespace string) (kubernetes.Interface, error) {
+	accessControlClientSet := k.manager.accessControlClientSet
+	apps := &rolloutAppsV1{}
+	var err error
+	switch gvk.Kind {
+	case "Deployment":
+		if apps.deployments, err = accessControlClientSet.Deployments(namespace); err == nil {
+			apps.replicaSets, err = accessControlClientSet.ReplicaSets(namespace)
+		}
+	case "StatefulSet":
+		if apps.statefulSets, err = accessControlClientSet.StatefulSets(namespace); err == nil {
+			apps.controllerRevisions, err = accessControlClientSet.ControllerRevisions(namespace)
+		}
+	case "DaemonSet":
+		if apps.daemonSets, err = accessControlClientSet.DaemonSets(namespace); err == nil {
+			apps.controllerRevisions, err = accessControlClientSet.ControllerRevisions(namespace)
+		}
+	default:
+		err = fmt.Errorf("unsupported rollout kind %q", gvk.Kind)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &rolloutKubernetesInterface{apps: apps}, nil
+}
+
+// rolloutKubernetesInterface only implements the AppsV1 client, any other client is nil
+type rolloutKubernetesInterface struct {
+	kubernetes.Interface
+	apps *rolloutAppsV1
+}
+
+func (r *rolloutKubernetesInterface) AppsV1() appsv1.AppsV1Interface {
+	return r.apps
+}
+
+// rolloutAppsV1 only implements the (access-controlled) typed clients resolved for the namespace of the workload,
+// the rollout helpers only access the namespace of the workload
+type rolloutAppsV1 struct {
+	appsv1.AppsV1Interface
+	deployments         appsv1.DeploymentInterface
+	replicaSets         appsv1.ReplicaSetInterface
+	statefulSets        appsv1.StatefulSetInterface
+	daemonSets          appsv1.DaemonSetInterface
+	controllerRevisions appsv1.ControllerRevisionInterface
+}
+
+func (r *rolloutAppsV1) Deployments(_ string) appsv1.DeploymentInterface {
+	return r.deployments
+}
+
+func (r *rolloutAppsV1) ReplicaSets(_ string) appsv1.ReplicaSetInterface {
+	return r.replicaSets
+}
+
+func (r *rolloutAppsV1) StatefulSets(_ string) appsv1.StatefulSetInterface {
+	return r.statefulSets
+}
+
+func (r *rolloutAppsV1) DaemonSets(_ string) appsv1.DaemonSetInterface {
+	return r.daemonSets
+}
+
+func (r *rolloutAppsV1) ControllerRevisions(_ string) appsv1.ControllerRevisionInterface {
+	return r.controllerRevisions
+}
@@ -0,0 +1,107 @@
+package mcp
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"sigs.k8s.io/yaml"
+
+	"github.com/manusa/kubernetes-mcp-server/pkg/config"
+	"github.com/manusa/kubernetes-mcp-server/pkg/kubernetes"
+)
+
+func TestAPIResources(t *testing.T) {
+	deniedResourcesServer := &config.StaticConfig{DeniedResources: []config.GroupVersionKind{
+		{Version: "v1", Kind: "Secret"},
+		{Group: "rbac.authorization.k8s.io", Version: "v1"},
+	}}
+	testCaseWithContext(t, &mcpContext{staticConfig: deniedResourcesServer}, func(c *mcpContext) {
+		mockServer := NewMockServer()
+		defer mockServer.Close()
+		c.withKubeConfig(mockServer.config)
+		mockServer.Handle(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+			w.Header().Set("Content-Type", "application/json")
+			switch req.URL.Path {
+			// Request Performed by DiscoveryClient to Kube API (Get API Groups legacy -core-)
+			case "/api":
+				_, _ = w.Write([]byte(`{"kind":"APIVersions","versions":["v1"],"serverAddressByClientCIDRs":[{"clientCIDR":"0.0.0.0/0"}]}`))
+			// Request Performed by DiscoveryClient to Kube API (Get API Groups)
+			case "/apis":
+				_, _ = w.Write([]byte(`{"kind":"APIGroupList","apiVersion":"v1","groups":[` +
+					`{"name":"apps","versions":[{"groupVersion":"apps/v1","version":"v1"}],"preferredVersion":{"groupVersion":"apps/v1","version":"v1"}},` +
+					`{"name":"rbac.authorization.k8s.io","versions":[{"groupVersion":"rbac.authorization.k8s.io/v1","version":"v1"}],"preferredVersion":{"groupVersion":"rbac.authorization.k8s.io/v1","version":"v1"}},` +
+					`{"name":"example.com","versions":[{"groupVersion":"example.com/v1","version":"v1"}],"preferredVersion":{"groupVersion":"example.com/v1","version":"v1"}}` +
+					`]}`))
+			// Request Performed by DiscoveryClient to Kube API (Get API Resources)
+			case "/api/v1":
+				_, _ = w.Write([]byte(`{"kind":"APIResourceList","apiVersion":"v1","groupVersion":"v1","resources":[` +
+					`{"name":"pods","singularName":"pod","namespaced":true,"kind":"Pod","verbs":["create","delete","get","list","patch","watch"],"shortNames":["po"]},` +
+					`{"name":"pods/log","singularName":"","namespaced":true,"kind":"Pod","verbs":["get"]},` +
+					`{"name":"secrets","singularName":"secret","namespaced":true,"kind":"Secret","verbs":["create","delete","get","list","patch","watch"]},` +
+					`{"name":"bindings","singularName":"binding","namespaced":true,"kind":"Binding","verbs":["create"]}` +
+					`]}`))
+			case "/apis/apps/v1":
+				_, _ = w.Write([]byte(`{"kind":"APIResourceList","apiVersion":"v1","groupVersion":"apps/v1","resources":[` +
+					`{"name":"deployments","singularName":"deployment","namespaced":true,"kind":"Deployment","verbs":["create","delete","get","list","patch","watch"],"shortNames":["deploy"]},` +
+					`{"name":"deployments/scale","singularName":"","namespaced":true,"group":"autoscaling","version":"v1","kind":"Scale","verbs":["get","patch","update"]}` +
+					`]}`))
+			case "/apis/rbac.authorization.k8s.io/v1":
+				_, _ = w.Write([]byte(`{"kind":"APIResourceList","apiVersion":"v1","groupVersion":"rbac.authorization.k8s.io/v1","resources":[` +
+					`{"name":"roles","singularName":"role","namespaced":true,"kind":"Role","verbs":["create","delete","get","list","patch","watch"]}` +
+					`]}`))
+			case "/apis/example.com/v1":
+				_, _ = w.Write([]byte(`{"kind":"APIResourceList","apiVersion":"v1","groupVersion":"example.com/v1","resources":[` +
+					`{"name":"widgets","singularName":"widget","namespaced":false,"kind":"Widget","verbs":["get","list"],"shortNames":["wdg"]}` +
+					`]}`))
+			}
+		}))
+		apiResources := func(t *testing.T, arguments map[string]interface{}) []kubernetes.APIResource {
+			toolResult, err := c.callTool("api_resources", arguments)
+			if err != nil || toolResult.IsError {
+				t.Fatalf("call tool failed %v %v", err, toolResult.Content)
+			}
+			var ret []kubernetes.APIResource
+			if err = yaml.Unmarshal([]byte(toolResult.Content[0].(mcp.TextContent).Text), &ret); err != nil {
+				t.Fatalf("invalid tool result content %v", err)
+			}
+			return ret
+		}
+		t.Run("api_resources returns allowed resources sorted by group and name", func(t *testing.T) {
+			ret := apiResources(t, map[string]interface{}{})
+			var names []string
+			for _, r := range ret {
+				names = append(names, r.APIVersion+" "+r.Kind)
+			}
+			expected := "[v1 Binding v1 Pod apps/v1 Deployment example.com/v1 Widget]"
+			if got := fmt.Sprint(names); got != expected {
+				t.Fatalf("unexpected resources, expected %s, got %s", expected, got)
+			}
+			if ret[1].Name != "pods" || !ret[1].Namespaced || len(ret[1].ShortNames) != 1 || ret[1].ShortNames[0] != "po" {
+				t.Errorf("unexpected pods resource %v", ret[1])
+			}
+			if ret[3].Namespaced || ret[3].ShortNames[0] != "wdg" {
+				t.Errorf("unexpected widgets resource %v", ret[3])
+			}
+		})
+		t.Run("api_resources filters by apiGroup", func(t *testing.T) {
+			ret := apiResources(t, map[string]interface{}{"apiGroup": "apps"})
+			if len(ret) != 1 || ret[0].Kind != "Deployment" {
+				t.Errorf("unexpected resources %v", ret)
+			}
+		})
+		t.Run("api_resources filters by core apiGroup", func(t *testing.T) {
+			ret := apiResources(t, map[string]interface{}{"apiGroup": "core"})
+			if len(ret) != 2 || ret[0].Kind != "Binding" || ret[1].Kind != "Pod" {
+				t.Errorf("unexpected resources %v", ret)
+			}
+		})
+		t.Run("api_resources filters by verbs", func(t *testing.T) {
+			ret := apiResources(t, map[string]interface{}{"verbs": []interface{}{"list", "watch"}})
+			if len(ret) != 2 || ret[0].Kind != "Pod" || ret[1].Kind != "Deployment" {
+				t.Errorf("unexpected resources %v", ret)
+			}
+		})
+	})
+}
@@ -0,0 +1,134 @@
+package mcp
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+
+	"github.com/manusa/kubernetes-mcp-server/pkg/output"
+)
+
+func (s *Server) initJobs() []server.ServerTool {
+	return []server.ServerTool{
+		{Tool: mcp.NewTool("jobs_create_from_cronjob",
+			mcp.WithDescription("Create (trigger) a Kubernetes Job from the jobTemplate of the CronJob in the current or provided namespace with the provided name, "+
+				"the same as kubectl create job --from=cronjob/name. Useful to re-run a failed scheduled Job"),
+			mcp.WithString("namespace", mcp.Description("Namespace of the CronJob, the Job is created in the same namespace")),
+			mcp.WithString("cronjob", mcp.Description("Name of the CronJob to create the Job from"), mcp.Required()),
+			mcp.WithString("name", mcp.Description("Name of the Job to create (Optional, random name prefixed with the CronJob name and -manual- if not provided)")),
+			// Tool annotations
+			mcp.WithTitleAnnotation("Jobs: Create from CronJob"),
+			mcp.WithReadOnlyHintAnnotation(false),
+			mcp.WithDestructiveHintAnnotation(false),
+			mcp.WithIdempotentHintAnnotation(false),
+			mcp.WithOpenWorldHintAnnotation(true),
+		), Handler: s.jobsCreateFromCronJob},
+		{Tool: mcp.NewTool("jobs_status",
+			mcp.WithDescription("Get a summary of the status of the Kubernetes Job in the current or provided namespace with the provided name, "+
+				"including the active, succeeded, and failed Pod counts, the completion time, the failure reasons, and the status of the Pods of the latest attempt"),
+			mcp.WithString("namespace", mcp.Description("Namespace of the Job")),
+			mcp.WithString("name", mcp.Description("Name of the Job"), mcp.Required()),
+			// Tool annotations
+			mcp.WithTitleAnnotation("Jobs: Status"),
+			mcp.WithReadOnlyHintAnnotation(true),
+			mcp.WithDestructiveHintAnnotation(false),
+			mcp.WithOpenWorldHintAnnotation(true),
+		), Handler: s.jobsStatus},
+		{Tool: mcp.NewTool("cronjobs_suspend",
+			mcp.WithDescription("Suspend the Kubernetes CronJob in the current or provided namespace with the provided name, no new Jobs are scheduled until it's resumed. "+
+				"Jobs that are already running are not affected"),
+			mcp.WithString("namespace", mcp.Description("Namespace of the CronJob")),
+			mcp.WithString("name", mcp.Description("Name of the CronJob"), mcp.Required()),
+			// Tool annotations
+			mcp.WithTitleAnnotation("CronJobs: Suspend"),
+			mcp.WithReadOnlyHintAnnotation(false),
+			mcp.WithDestructiveHintAnnotation(false),
+			mcp.WithIdempotentHintAnnotation(true),
+			mcp.WithOpenWorldHintAnnotation(true),
+		), Handler: s.cronJobsSuspend},
+		{Tool: mcp.NewTool("cronjobs_resume",
+			mcp.WithDescription("Resume the Kubernetes CronJob in the current or provided namespace with the provided name, Jobs are scheduled again according to its schedule"),
+			mcp.WithString("namespace", mcp.Description("Namespace of the CronJob")),
+			mcp.WithString("name", mcp.Description("Name of the CronJob"), mcp.Required()),
+			// Tool annotations
+			mcp.WithTitleAnnotation("CronJobs: Resume"),
+			mcp.WithReadOnlyHintAnnotation(false),
+			mcp.WithDestructiveHintAnnotation(false),
+			mcp.WithIdempotentHintAnnotation(true),
+			mcp.WithOpenWorldHintAnnotation(true),
+		), Handler: s.cronJobsResume},
+	}
+}
+
+func (s *Server) jobsCreateFromCronJob(ctx context.Context, ctr mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	ns, _ := ctr.GetArguments()["namespace"].(string)
+	cronJob, ok := ctr.GetArguments()["cronjob"].(string)
+	if !ok || cronJob == "" {
+		return NewTextResult("", errors.New("failed to create job from cronjob, missing argument cronjob")), nil
+	}
+	name, _ := ctr.GetArguments()["name"].(string)
+	derived, err := s.k.Derived(ctx)
+	if err != nil {
+		return nil, err
+	}
+	ret, err := derived.JobsCreateFromCronJob(ctx, ns, cronJob, name)
+	if err != nil {
+		return NewTextResult("", fmt.Errorf("failed to create job from cronjob %s in namespace %s: %v", cronJob, ns, err)), nil
+	}
+	marshalledYaml, err := output.MarshalYaml(ret)
+	if err != nil {
+		err = fmt.Errorf("failed to create job from cronjob: %v", err)
+	}
+	return NewTextResult("# The following Job (YAML) has been created successfully\n"+marshalledYaml, err), nil
+}
+
+func (s *Server) jobsStatus(ctx context.Context, ctr mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	ns, _ := ctr.GetArguments()["namespace"].(string)
+	name, ok := ctr.GetArguments()["name"].(string)
+	if !ok || name == "" {
+		return NewTextResult("", errors.New("failed to get job status, missing argument name")), nil
+	}
+	derived, err := s.k.Derived(ctx)
+	if err != nil {
+		return nil, err
+	}
+	ret, err := derived.JobsStatus(ctx, ns, name)
+	if err != nil {
+		return NewTextResult("", fmt.Errorf("failed to get job status of %s in namespace %s: %v", name, ns, err)), nil
+	}
+	return NewTextResult(output.MarshalYaml(ret)), nil
+}
+
+func (s *Server) cronJobsSuspend(ctx context.Context, ctr mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	return s.cronJobsSetSuspend(ctx, ctr, true)
+}
+
+func (s *Server) cronJobsResume(ctx context.Context, ctr mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	return s.cronJobsSetSuspend(ctx, ctr, false)
+}
+
+func (s *Server) cronJobsSetSuspend(ctx context.Context, ctr mcp.CallToolRequest, suspend bool) (*mcp.CallToolResult, error) {
+	action := "resume"
+	if suspend {
+		action = "suspend"
+	}
+	ns, _ := ctr.GetArguments()["namespace"].(string)
+	name, ok := ctr.GetArguments()["name"].(string)
+	if !ok || name == "" {
+		return NewTextResult("", fmt.Errorf("failed to %s cronjob, missing argument name", action)), nil
+	}
+	derived, err := s.k.Derived(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if _, err = derived.CronJobsSuspend(ctx, ns, name, suspend); err != nil {
+		return NewTextResult("", fmt.Errorf("failed to %s cronjob %s in namespace %s: %v", action, name, ns, err)), nil
+	}
+	if suspend {
+		return NewTextResult(fmt.Sprintf("CronJob %s suspended successfully", name), nil), nil
+	}
+	return NewTextResult(fmt.Sprintf("CronJob %s resumed successfully", name), nil), nil
+}
@@ -0,0 +1,212 @@
+package mcp
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	batchv1 "k8s.io/api/batch/v1"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/yaml"
+
+	"github.com/manusa/kubernetes-mcp-server/pkg/config"
+	"github.com/manusa/kubernetes-mcp-server/pkg/kubernetes"
+)
+
+func TestJobs(t *testing.T) {
+	testCase(t, func(c *mcpContext) {
+		mockServer := NewMockServer()
+		defer mockServer.Close()
+		c.withKubeConfig(mockServer.config)
+		applied := map[string]map[string]interface{}{}
+		now := time.Now()
+		pod := func(name string, created time.Time, exitCode int32) v1.Pod {
+			return v1.Pod{
+				ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: name, CreationTimestamp: metav1.NewTime(created)},
+				Status: v1.PodStatus{Phase: v1.PodFailed, ContainerStatuses: []v1.ContainerStatus{{
+					Name: "backup", State: v1.ContainerState{Terminated: &v1.ContainerStateTerminated{Reason: "Error", ExitCode: exitCode}},
+				}}},
+			}
+		}
+		mockServer.Handle(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+			w.Header().Set("Content-Type", "application/json")
+			switch req.URL.Path {
+			// Request Performed by DiscoveryClient to Kube API (Get API Groups legacy -core-)
+			case "/api":
+				_, _ = w.Write([]byte(`{"kind":"APIVersions","versions":["v1"],"serverAddressByClientCIDRs":[{"clientCIDR":"0.0.0.0/0"}]}`))
+			// Request Performed by DiscoveryClient to Kube API (Get API Groups)
+			case "/apis":
+				_, _ = w.Write([]byte(`{"kind":"APIGroupList","apiVersion":"v1","groups":[` +
+					`{"name":"batch","versions":[{"groupVersion":"batch/v1","version":"v1"}],"preferredVersion":{"groupVersion":"batch/v1","version":"v1"}}` +
+					`]}`))
+			// Request Performed by DiscoveryClient to Kube API (Get API Resources)
+			case "/apis/batch/v1":
+				_, _ = w.Write([]byte(`{"kind":"APIResourceList","apiVersion":"v1","groupVersion":"batch/v1","resources":[` +
+					`{"name":"jobs","singularName":"","namespaced":true,"kind":"Job","verbs":["get","list","patch"]},` +
+					`{"name":"cronjobs","singularName":"","namespaced":true,"kind":"CronJob","verbs":["get","list","patch"]}` +
+					`]}`))
+			case "/apis/batch/v1/namespaces/default/cronjobs/nightly":
+				if req.Method == http.MethodPatch {
+					body, _ := io.ReadAll(req.Body)
+					obj := map[string]interface{}{}
+					_ = json.Unmarshal(body, &obj)
+					applied["nightly"] = obj
+					_, _ = w.Write(body)
+					return
+				}
+				writeObject(w, &batchv1.CronJob{
+					TypeMeta:   metav1.TypeMeta{APIVersion: "batch/v1", Kind: "CronJob"},
+					ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "nightly", UID: "nightly-uid"},
+					Spec: batchv1.CronJobSpec{Schedule: "0 0 * * *", JobTemplate: batchv1.JobTemplateSpec{
+						ObjectMeta: metav1.ObjectMeta{Labels: map[string]string{"app": "backup"}},
+						Spec: batchv1.JobSpec{BackoffLimit: ptr.To(int32(2)), Template: v1.PodTemplateSpec{Spec: v1.PodSpec{
+							RestartPolicy: v1.RestartPolicyNever,
+							Containers:    []v1.Container{{Name: "backup", Image: "backup:latest"}},
+						}}},
+					}},
+				})
+			case "/apis/batch/v1/namespaces/default/jobs/nightly-rerun":
+				body, _ := io.ReadAll(req.Body)
+				obj := map[string]interface{}{}
+				_ = json.Unmarshal(body, &obj)
+				applied["nightly-rerun"] = obj
+				_, _ = w.Write(body)
+			case "/apis/batch/v1/namespaces/default/jobs/failed-job":
+				writeObject(w, &batchv1.Job{
+					TypeMeta:   metav1.TypeMeta{APIVersion: "batch/v1", Kind: "Job"},
+					ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "failed-job"},
+					Spec:       batchv1.JobSpec{Selector: &metav1.LabelSelector{MatchLabels: map[string]string{"batch.kubernetes.io/controller-uid": "failed-uid"}}},
+					Status: batchv1.JobStatus{Failed: 3, Conditions: []batchv1.JobCondition{
+						{Type: batchv1.JobFailed, Status: v1.ConditionTrue, Reason: "BackoffLimitExceeded", Message: "Job has reached the specified backoff limit"},
+					}},
+				})
+			case "/api/v1/namespaces/default/pods":
+				if req.URL.Query().Get("labelSelector") != "batch.kubernetes.io/controller-uid=failed-uid" {
+					return
+				}
+				writeObject(w, &v1.PodList{
+					TypeMeta: metav1.TypeMeta{APIVersion: "v1", Kind: "PodList"},
+					Items: []v1.Pod{
+						pod("failed-job-first", now.Add(-2*time.Minute), 1),
+						pod("failed-job-last", now, 2),
+						pod("failed-job-second", now.Add(-time.Minute), 1),
+					},
+				})
+			}
+		}))
+		t.Run("jobs_create_from_cronjob with missing cronjob returns error", func(t *testing.T) {
+			toolResult, _ := c.callTool("jobs_create_from_cronjob", map[string]interface{}{})
+			if !toolResult.IsError {
+				t.Fatalf("call tool should fail")
+			}
+			if toolResult.Content[0].(mcp.TextContent).Text != "failed to create job from cronjob, missing argument cronjob" {
+				t.Fatalf("invalid error message, got %v", toolResult.Content[0].(mcp.TextContent).Text)
+			}
+		})
+		t.Run("jobs_create_from_cronjob creates job from job template", func(t *testing.T) {
+			toolResult, err := c.callTool("jobs_create_from_cronjob", map[string]interface{}{"cronjob": "nightly", "name": "nightly-rerun"})
+			if err != nil || toolResult.IsError {
+				t.Fatalf("call tool failed %v %v", err, toolResult.Content)
+			}
+			job := &batchv1.Job{}
+			raw, _ := json.Marshal(applied["nightly-rerun"])
+			_ = json.Unmarshal(raw, job)
+			if job.Spec.Template.Spec.Containers[0].Image != "backup:latest" || *job.Spec.BackoffLimit != 2 {
+				t.Errorf("unexpected job spec %v", job.Spec)
+			}
+			if job.Labels["app"] != "backup" || job.Annotations["cronjob.kubernetes.io/instantiate"] != "manual" {
+				t.Errorf("unexpected job metadata %v", job.ObjectMeta)
+			}
+			if len(job.OwnerReferences) != 1 || job.OwnerReferences[0].Kind != "CronJob" || job.OwnerReferences[0].UID != "nightly-uid" {
+				t.Errorf("unexpected owner references %v", job.OwnerReferences)
+			}
+		})
+		t.Run("cronjobs_suspend suspends cronjob", func(t *testing.T) {
+			toolResult, err := c.callTool("cronjobs_suspend", map[string]interface{}{"name": "nightly"})
+			if err != nil || toolResult.IsError {
+				t.Fatalf("call tool failed %v %v", err, toolResult.Content)
+			}
+			if toolResult.Content[0].(mcp.TextContent).Text != "CronJob nightly suspended successfully" {
+				t.Errorf("unexpected result %v", toolResult.Content[0].(mcp.TextContent).Text)
+			}
+			if applied["nightly"]["spec"].(map[string]interface{})["suspend"] != true {
+				t.Errorf("unexpected applied cronjob %v", applied["nightly"])
+			}
+			if _, ok := applied["nightly"]["spec"].(map[string]interface{})["schedule"]; ok {
+				t.Errorf("expected only suspend field to be applied, got %v", applied["nightly"])
+			}
+		})
+		t.Run("cronjobs_resume resumes cronjob", func(t *testing.T) {
+			toolResult, err := c.callTool("cronjobs_resume", map[string]interface{}{"name": "nightly"})
+			if err != nil || toolResult.IsError {
+				t.Fatalf("call tool failed %v %v", err, toolResult.Content)
+			}
+			if applied["nightly"]["spec"].(map[string]interface{})["suspend"] != false {
+				t.Errorf("unexpected applied cronjob %v", applied["nightly"])
+			}
+		})
+		t.Run("jobs_status with missing name returns error", func(t *testing.T) {
+			toolResult, _ := c.callTool("jobs_status", map[string]interface{}{})
+			if !toolResult.IsError {
+				t.Fatalf("call tool should fail")
+			}
+			if toolResult.Content[0].(mcp.TextContent).Text != "failed to get job status, missing argument name" {
+				t.Fatalf("invalid error message, got %v", toolResult.Content[0].(mcp.TextContent).Text)
+			}
+		})
+		toolResult, err := c.callTool("jobs_status", map[string]interface{}{"name": "failed-job"})
+		var status kubernetes.JobStatusSummary
+		t.Run("jobs_status returns status summary", func(t *testing.T) {
+			if err != nil || toolResult.IsError {
+				t.Fatalf("call tool failed %v %v", err, toolResult.Content)
+			}
+			if err = yaml.Unmarshal([]byte(toolResult.Content[0].(mcp.TextContent).Text), &status); err != nil {
+				t.Fatalf("invalid tool result content %v", err)
+			}
+			if status.Status != "Failed" || status.Failed != 3 {
+				t.Errorf("unexpected status %v", status)
+			}
+		})
+		t.Run("jobs_status returns failure reasons", func(t *testing.T) {
+			reasons := strings.Join(status.FailureReasons, "\n")
+			for _, expected := range []string{
+				"job failed: BackoffLimitExceeded: Job has reached the specified backoff limit",
+				"pod failed-job-last container backup terminated with reason Error (exit code 2)",
+			} {
+				if !strings.Contains(reasons, expected) {
+					t.Errorf("expected failure reason %s, got %v", expected, reasons)
+				}
+			}
+		})
+		t.Run("jobs_status returns pods of latest attempt", func(t *testing.T) {
+			if len(status.Pods) != 1 || status.Pods[0].Name != "failed-job-last" {
+				t.Errorf("unexpected pods %v", status.Pods)
+			}
+		})
+	})
+}
+
+func TestJobsDenied(t *testing.T) {
+	deniedResourcesServer := &config.StaticConfig{DeniedResources: []config.GroupVersionKind{{Group: "batch", Version: "v1", Kind: "CronJob"}}}
+	testCaseWithContext(t, &mcpContext{staticConfig: deniedResourcesServer}, func(c *mcpContext) {
+		c.withEnvTest()
+		cronJobsSuspend, _ := c.callTool("cronjobs_suspend", map[string]interface{}{"name": "a-cronjob"})
+		t.Run("cronjobs_suspend has error", func(t *testing.T) {
+			if !cronJobsSuspend.IsError {
+				t.Fatalf("call tool should fail")
+			}
+		})
+		t.Run("cronjobs_suspend describes denial", func(t *testing.T) {
+			expectedMessage := "failed to suspend cronjob a-cronjob in namespace : resource not allowed: batch/v1, Kind=CronJob"
+			if cronJobsSuspend.Content[0].(mcp.TextContent).Text != expectedMessage {
+				t.Fatalf("expected descriptive error '%s', got %v", expectedMessage, cronJobsSuspend.Content[0].(mcp.TextContent).Text)
+			}
+		})
+	})
+}
@@ -45,9 +45,11 @@ type Server struct {
 	configuration *Configuration
 	server        *server.MCPServer
 	k             *internalk8s.Manager
+	portForwards  *internalk8s.PortForwardRegistry
 }
 
 func NewServer(configuration Configuration) (*Server, error) {
+	hooks := &server.Hooks{}
 	s := &Server{
 		configuration: &configuration,
 		server: server.NewMCPServer(
@@ -58,8 +60,16 @@ func NewServer(configuration Configuration) (*Server, error) {
 			server.WithToolCapabilities(true),
 			server.WithLogging(),
 			server.WithToolHandlerMiddleware(toolCallLoggingMiddleware),
+			server.WithHooks(hooks),
 		),
+		portForwards: internalk8s.NewPortForwardRegistry(),
 	}
+	// Port-forward sessions are released when the MCP session that started them is closed
+	hooks.AddOnUnregisterSession(func(_ context.Context, session server.ClientSession) {
+		if session.SessionID() != "" {
+			s.portForwards.StopAll(session.SessionID())
+		}
+	})
 	if err := s.reloadKubernetesClient(); err != nil {
 		return nil, err
 	}
@@ -125,6 +135,7 @@ func (s *Server) GetKubernetesAPIServerHost() string {
 }
 
 func (s *Server) Close() {
+	s.portForwards.Close()
 	if s.k != nil {
 		s.k.Close()
 	}
@@ -152,6 +163,38 @@ func NewTextResult(content string, err error) *mcp.CallToolResult {
 	}
 }
 
+// withStringArrayItems sets the items of an array property to strings.
+// TODO: manual fix to ensure that the items property gets initialized (Gemini)
+// https://www.googlecloudcommunity.com/gc/AI-ML/Gemini-API-400-Bad-Request-Array-fields-breaks-function-calling/m-p/769835?nobounce
+func withStringArrayItems() mcp.PropertyOption {
+	return func(schema map[string]interface{}) {
+		schema["type"] = "array"
+		schema["items"] = map[string]interface{}{
+			"type": "string",
+		}
+	}
+}
+
+// sendProgressNotification notifies the client about the progress of a long-running tool call (if the client requested it by providing a progress token)
+func sendProgressNotification(ctx context.Context, ctr mcp.CallToolRequest, progress, total int, message string) {
+	if ctr.Params.Meta == nil || ctr.Params.Meta.ProgressToken == nil {
+		return
+	}
+	mcpServer := server.ServerFromContext(ctx)
+	if mcpServer == nil {
+		return
+	}
+	err := mcpServer.SendNotificationToClient(ctx, "notifications/progress", map[string]any{
+		"progressToken": ctr.Params.Meta.ProgressToken,
+		"progress":      progress,
+		"total":         total,
+		"message":       message,
+	})
+	if err != nil {
+		klog.V(5).Infof("failed to send progress notification: %v", err)
+	}
+}
+
 func contextFunc(ctx context.Context, r *http.Request) context.Context {
 	// Get the standard Authorization header (OAuth compliant)
 	authHeader := r.Header.Get(string(internalk8s.OAuthAuthorizationHeader))
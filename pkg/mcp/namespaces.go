@@ -15,6 +15,9 @@ func (s *Server) initNamespaces() []server.ServerTool {
 	ret = append(ret, server.ServerTool{
 		Tool: mcp.NewTool("namespaces_list",
 			mcp.WithDescription("List all the Kubernetes namespaces in the current cluster"),
+			mcp.WithString("fieldSelector", mcp.Description("Optional Kubernetes field selector (e.g. 'status.phase=Active' or 'metadata.name!=default'), use this option when you want to filter the namespaces by field")),
+			mcp.WithNumber("limit", mcp.Description("Maximum number of namespaces to return, use with continue to paginate large result sets (Optional, all namespaces if not provided)")),
+			mcp.WithString("continue", mcp.Description("Optional continue token returned by a previous call with limit, use it to retrieve the next page of namespaces")),
 			// Tool annotations
 			mcp.WithTitleAnnotation("Namespaces: List"),
 			mcp.WithReadOnlyHintAnnotation(true),
@@ -37,16 +40,20 @@ func (s *Server) initNamespaces() []server.ServerTool {
 	return ret
 }
 
-func (s *Server) namespacesList(ctx context.Context, _ mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+func (s *Server) namespacesList(ctx context.Context, ctr mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	resourceListOptions, err := s.resourceListOptions(ctr.GetArguments())
+	if err != nil {
+		return NewTextResult("", fmt.Errorf("failed to list namespaces, %s", err)), nil
+	}
 	derived, err := s.k.Derived(ctx)
 	if err != nil {
 		return nil, err
 	}
-	ret, err := derived.NamespacesList(ctx, kubernetes.ResourceListOptions{AsTable: s.configuration.ListOutput.AsTable()})
+	ret, err := derived.NamespacesList(ctx, resourceListOptions)
 	if err != nil {
 		return NewTextResult("", fmt.Errorf("failed to list namespaces: %v", err)), nil
 	}
-	return NewTextResult(s.configuration.ListOutput.PrintObj(ret)), nil
+	return NewTextResult(s.printList(ret, nil)), nil
 }
 
 func (s *Server) projectsList(ctx context.Context, _ mcp.CallToolRequest) (*mcp.CallToolResult, error) {
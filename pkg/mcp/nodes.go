@@ -0,0 +1,193 @@
+package mcp
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+	"k8s.io/kubectl/pkg/metricsutil"
+
+	"github.com/manusa/kubernetes-mcp-server/pkg/kubernetes"
+	"github.com/manusa/kubernetes-mcp-server/pkg/output"
+)
+
+func (s *Server) initNodes() []server.ServerTool {
+	return []server.ServerTool{
+		{Tool: mcp.NewTool("nodes_top",
+			mcp.WithDescription("List the resource consumption (CPU and memory) as recorded by the Kubernetes Metrics Server for the Kubernetes Nodes in the current cluster, including the percentage of the allocatable resources in use"),
+			mcp.WithString("name", mcp.Description("Name of the Node to get the resource consumption from (Optional, all Nodes if not provided)")),
+			mcp.WithString("label_selector", mcp.Description("Kubernetes label selector (e.g. 'node-role.kubernetes.io/worker='), use this option when you want to filter the nodes by label (Optional, only applicable when name is not provided)"), mcp.Pattern("([A-Za-z0-9][-A-Za-z0-9_.]*)?[A-Za-z0-9]")),
+			mcp.WithString("sort_by", mcp.Description("Sort the Nodes by their resource consumption in descending order (Optional, sorted by name if not provided)"), mcp.Enum("cpu", "memory")),
+			mcp.WithNumber("limit", mcp.Description("Maximum number of Nodes to return after sorting, use with sort_by to find the top consumers (Optional, all Nodes if not provided)")),
+			// Tool annotations
+			mcp.WithTitleAnnotation("Nodes: Top"),
+			mcp.WithReadOnlyHintAnnotation(true),
+			mcp.WithDestructiveHintAnnotation(false),
+			mcp.WithIdempotentHintAnnotation(true),
+			mcp.WithOpenWorldHintAnnotation(true),
+		), Handler: s.nodesTop},
+		{Tool: mcp.NewTool("nodes_cordon",
+			mcp.WithDescription("Cordon a Kubernetes Node in the current cluster with the provided name, marking it as unschedulable so that no new Pods are scheduled on it. "+
+				"Pods already running on the Node are not affected"),
+			mcp.WithString("name", mcp.Description("Name of the Node to cordon"), mcp.Required()),
+			// Tool annotations
+			mcp.WithTitleAnnotation("Nodes: Cordon"),
+			mcp.WithReadOnlyHintAnnotation(false),
+			mcp.WithDestructiveHintAnnotation(false),
+			mcp.WithIdempotentHintAnnotation(true),
+			mcp.WithOpenWorldHintAnnotation(true),
+		), Handler: s.nodesCordon},
+		{Tool: mcp.NewTool("nodes_uncordon",
+			mcp.WithDescription("Uncordon a Kubernetes Node in the current cluster with the provided name, marking it as schedulable again"),
+			mcp.WithString("name", mcp.Description("Name of the Node to uncordon"), mcp.Required()),
+			// Tool annotations
+			mcp.WithTitleAnnotation("Nodes: Uncordon"),
+			mcp.WithReadOnlyHintAnnotation(false),
+			mcp.WithDestructiveHintAnnotation(false),
+			mcp.WithIdempotentHintAnnotation(true),
+			mcp.WithOpenWorldHintAnnotation(true),
+		), Handler: s.nodesUncordon},
+		{Tool: mcp.NewTool("nodes_drain",
+			mcp.WithDescription("Drain a Kubernetes Node in the current cluster with the provided name in preparation for maintenance. "+
+				"The Node is cordoned and its Pods are evicted honoring their PodDisruptionBudgets. "+
+				"Reports the Pods that were evicted and the Pods whose eviction was blocked (the Node remains cordoned), the Node is uncordoned if the drain fails before evicting any Pod"),
+			mcp.WithString("name", mcp.Description("Name of the Node to drain"), mcp.Required()),
+			mcp.WithBoolean("ignoreDaemonSets", mcp.Description("Ignore DaemonSet-managed Pods, otherwise the drain fails if there are any (Optional, defaults to false)")),
+			mcp.WithBoolean("deleteEmptyDirData", mcp.Description("Evict Pods using emptyDir volumes even if their data is lost, otherwise the drain fails if there are any (Optional, defaults to false)")),
+			mcp.WithBoolean("force", mcp.Description("Evict Pods that are not managed by a controller (they won't be recreated), otherwise the drain fails if there are any (Optional, defaults to false)")),
+			mcp.WithNumber("gracePeriodSeconds", mcp.Description("Duration in seconds for each Pod to terminate gracefully (Optional, defaults to the Pod's terminationGracePeriodSeconds)")),
+			mcp.WithString("timeout", mcp.Description("Maximum duration to wait for the evictions to complete like 30s or 5m (Optional, defaults to 5m)")),
+			mcp.WithBoolean("dryRun", mcp.Description("Only report the Pods that would be evicted without cordoning the Node (Optional, defaults to false)")),
+			// Tool annotations
+			mcp.WithTitleAnnotation("Nodes: Drain"),
+			mcp.WithReadOnlyHintAnnotation(false),
+			mcp.WithDestructiveHintAnnotation(true),
+			mcp.WithIdempotentHintAnnotation(true),
+			mcp.WithOpenWorldHintAnnotation(true),
+		), Handler: s.nodesDrain},
+	}
+}
+
+func (s *Server) nodesTop(ctx context.Context, ctr mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	nodesTopOptions := kubernetes.NodesTopOptions{}
+	if v, ok := ctr.GetArguments()["name"].(string); ok {
+		nodesTopOptions.Name = v
+	}
+	if v, ok := ctr.GetArguments()["label_selector"].(string); ok {
+		nodesTopOptions.LabelSelector = v
+	}
+	if v, ok := ctr.GetArguments()["sort_by"].(string); ok {
+		nodesTopOptions.SortBy = v
+	}
+	if v, ok := ctr.GetArguments()["limit"].(float64); ok {
+		nodesTopOptions.Limit = int(v)
+	}
+	derived, err := s.k.Derived(ctx)
+	if err != nil {
+		return nil, err
+	}
+	ret, allocatable, err := derived.NodesTop(ctx, nodesTopOptions)
+	if err != nil {
+		return NewTextResult("", fmt.Errorf("failed to get nodes top: %v", err)), nil
+	}
+	buf := new(bytes.Buffer)
+	printer := metricsutil.NewTopCmdPrinter(buf)
+	err = printer.PrintNodeMetrics(ret.Items, allocatable, false, nodesTopOptions.SortBy)
+	if err != nil {
+		return NewTextResult("", fmt.Errorf("failed to get nodes top: %v", err)), nil
+	}
+	return NewTextResult(buf.String(), nil), nil
+}
+
+func (s *Server) nodesCordon(ctx context.Context, ctr mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	name, ok := ctr.GetArguments()["name"].(string)
+	if !ok || name == "" {
+		return NewTextResult("", errors.New("failed to cordon node, missing argument name")), nil
+	}
+	derived, err := s.k.Derived(ctx)
+	if err != nil {
+		return nil, err
+	}
+	updated, err := derived.NodesCordon(ctx, name, true)
+	if err != nil {
+		return NewTextResult("", fmt.Errorf("failed to cordon node %s: %v", name, err)), nil
+	}
+	if !updated {
+		return NewTextResult(fmt.Sprintf("Node %s is already cordoned", name), nil), nil
+	}
+	return NewTextResult(fmt.Sprintf("Node %s cordoned successfully", name), nil), nil
+}
+
+func (s *Server) nodesUncordon(ctx context.Context, ctr mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	name, ok := ctr.GetArguments()["name"].(string)
+	if !ok || name == "" {
+		return NewTextResult("", errors.New("failed to uncordon node, missing argument name")), nil
+	}
+	derived, err := s.k.Derived(ctx)
+	if err != nil {
+		return nil, err
+	}
+	updated, err := derived.NodesCordon(ctx, name, false)
+	if err != nil {
+		return NewTextResult("", fmt.Errorf("failed to uncordon node %s: %v", name, err)), nil
+	}
+	if !updated {
+		return NewTextResult(fmt.Sprintf("Node %s is already uncordoned", name), nil), nil
+	}
+	return NewTextResult(fmt.Sprintf("Node %s uncordoned successfully", name), nil), nil
+}
+
+func (s *Server) nodesDrain(ctx context.Context, ctr mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	name, ok := ctr.GetArguments()["name"].(string)
+	if !ok || name == "" {
+		return NewTextResult("", errors.New("failed to drain node, missing argument name")), nil
+	}
+	nodesDrainOptions := kubernetes.NodesDrainOptions{GracePeriodSeconds: -1, Timeout: 5 * time.Minute}
+	if v, ok := ctr.GetArguments()["ignoreDaemonSets"].(bool); ok {
+		nodesDrainOptions.IgnoreDaemonSets = v
+	}
+	if v, ok := ctr.GetArguments()["deleteEmptyDirData"].(bool); ok {
+		nodesDrainOptions.DeleteEmptyDirData = v
+	}
+	if v, ok := ctr.GetArguments()["force"].(bool); ok {
+		nodesDrainOptions.Force = v
+	}
+	if v, ok := ctr.GetArguments()["gracePeriodSeconds"].(float64); ok {
+		nodesDrainOptions.GracePeriodSeconds = int(v)
+	}
+	if v, ok := ctr.GetArguments()["timeout"].(string); ok && v != "" {
+		timeout, err := time.ParseDuration(v)
+		if err != nil || timeout <= 0 {
+			return NewTextResult("", fmt.Errorf("failed to drain node, invalid argument timeout: %s", v)), nil
+		}
+		nodesDrainOptions.Timeout = timeout
+	}
+	if v, ok := ctr.GetArguments()["dryRun"].(bool); ok {
+		nodesDrainOptions.DryRun = v
+	}
+	derived, err := s.k.Derived(ctx)
+	if err != nil {
+		return nil, err
+	}
+	ret, err := derived.NodesDrain(ctx, name, nodesDrainOptions)
+	if err != nil {
+		return NewTextResult("", fmt.Errorf("failed to drain node %s: %v", name, err)), nil
+	}
+	marshalledYaml, err := output.MarshalYaml(ret)
+	if err != nil {
+		return NewTextResult("", fmt.Errorf("failed to drain node %s: %v", name, err)), nil
+	}
+	switch {
+	case len(ret.Errors) > 0:
+		return NewTextResult("", fmt.Errorf("failed to drain node %s, the following Pods can't be evicted (YAML)\n%s", name, marshalledYaml)), nil
+	case ret.DryRun:
+		return NewTextResult("# The following Pods would be evicted from the Node (YAML), the Node has not been cordoned (dry run)\n"+marshalledYaml, nil), nil
+	case len(ret.Blocked) > 0:
+		return NewTextResult("", fmt.Errorf("failed to drain node %s, the eviction of some Pods was blocked (YAML)\n%s", name, marshalledYaml)), nil
+	}
+	return NewTextResult("# The Node has been drained successfully (YAML)\n"+marshalledYaml, nil), nil
+}
@@ -0,0 +1,415 @@
+package mcp
+
+import (
+	"io"
+	"net/http"
+	"regexp"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	appsv1 "k8s.io/api/apps/v1"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/yaml"
+
+	"github.com/manusa/kubernetes-mcp-server/pkg/config"
+	"github.com/manusa/kubernetes-mcp-server/pkg/kubernetes"
+)
+
+func TestNodesTopMetricsUnavailable(t *testing.T) {
+	testCase(t, func(c *mcpContext) {
+		mockServer := NewMockServer()
+		defer mockServer.Close()
+		c.withKubeConfig(mockServer.config)
+		mockServer.Handle(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+			w.Header().Set("Content-Type", "application/json")
+			// Request Performed by DiscoveryClient to Kube API (Get API Groups legacy -core-)
+			if req.URL.Path == "/api" {
+				_, _ = w.Write([]byte(`{"kind":"APIVersions","versions":[],"serverAddressByClientCIDRs":[{"clientCIDR":"0.0.0.0/0"}]}`))
+				return
+			}
+			// Request Performed by DiscoveryClient to Kube API (Get API Groups)
+			if req.URL.Path == "/apis" {
+				_, _ = w.Write([]byte(`{"kind":"APIGroupList","apiVersion":"v1","groups":[]}`))
+				return
+			}
+		}))
+		nodesTop, err := c.callTool("nodes_top", map[string]interface{}{})
+		t.Run("nodes_top with metrics API not available", func(t *testing.T) {
+			if err != nil {
+				t.Fatalf("call tool failed %v", err)
+			}
+			if !nodesTop.IsError {
+				t.Errorf("call tool should have returned an error")
+			}
+			if nodesTop.Content[0].(mcp.TextContent).Text != "failed to get nodes top: metrics API is not available" {
+				t.Errorf("call tool returned unexpected content: %s", nodesTop.Content[0].(mcp.TextContent).Text)
+			}
+		})
+	})
+}
+
+func TestNodesTopMetricsAvailable(t *testing.T) {
+	testCase(t, func(c *mcpContext) {
+		mockServer := NewMockServer()
+		defer mockServer.Close()
+		c.withKubeConfig(mockServer.config)
+		mockServer.Handle(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+			w.Header().Set("Content-Type", "application/json")
+			switch req.URL.Path {
+			// Request Performed by DiscoveryClient to Kube API (Get API Groups legacy -core-)
+			case "/api":
+				_, _ = w.Write([]byte(`{"kind":"APIVersions","versions":["v1","metrics.k8s.io/v1beta1"],"serverAddressByClientCIDRs":[{"clientCIDR":"0.0.0.0/0"}]}`))
+			// Request Performed by DiscoveryClient to Kube API (Get API Groups)
+			case "/apis":
+				_, _ = w.Write([]byte(`{"kind":"APIGroupList","apiVersion":"v1","groups":[]}`))
+			// Request Performed by DiscoveryClient to Kube API (Get API Resources)
+			case "/api/v1":
+				_, _ = w.Write([]byte(`{"kind":"APIResourceList","apiVersion":"v1","groupVersion":"v1","resources":[{"name":"nodes","singularName":"","namespaced":false,"kind":"Node","verbs":["get","list"]}]}`))
+			case "/apis/metrics.k8s.io/v1beta1":
+				_, _ = w.Write([]byte(`{"kind":"APIResourceList","apiVersion":"v1","groupVersion":"metrics.k8s.io/v1beta1","resources":[{"name":"nodes","singularName":"","namespaced":false,"kind":"NodeMetrics","verbs":["get","list"]}]}`))
+			// Node Metrics
+			case "/apis/metrics.k8s.io/v1beta1/nodes":
+				_, _ = w.Write([]byte(`{"kind":"NodeMetricsList","apiVersion":"metrics.k8s.io/v1beta1","items":[` +
+					`{"metadata":{"name":"node-1"},"usage":{"cpu":"500m","memory":"1Gi"}},` +
+					`{"metadata":{"name":"node-2"},"usage":{"cpu":"1","memory":"3Gi"}}` +
+					`]}`))
+			case "/apis/metrics.k8s.io/v1beta1/nodes/node-1":
+				_, _ = w.Write([]byte(`{"kind":"NodeMetrics","apiVersion":"metrics.k8s.io/v1beta1","metadata":{"name":"node-1"},"usage":{"cpu":"500m","memory":"1Gi"}}`))
+			// Nodes (allocatable resources)
+			case "/api/v1/nodes":
+				_, _ = w.Write([]byte(`{"kind":"NodeList","apiVersion":"v1","items":[` +
+					`{"metadata":{"name":"node-1"},"status":{"allocatable":{"cpu":"2","memory":"4Gi"}}},` +
+					`{"metadata":{"name":"node-2"},"status":{"allocatable":{"cpu":"2","memory":"4Gi"}}}` +
+					`]}`))
+			case "/api/v1/nodes/node-1":
+				_, _ = w.Write([]byte(`{"kind":"Node","apiVersion":"v1","metadata":{"name":"node-1"},"status":{"allocatable":{"cpu":"2","memory":"4Gi"}}}`))
+			}
+		}))
+		nodesTopDefaults, err := c.callTool("nodes_top", map[string]interface{}{})
+		t.Run("nodes_top defaults returns metrics for all nodes", func(t *testing.T) {
+			if err != nil {
+				t.Fatalf("call tool failed %v", err)
+			}
+			textContent := nodesTopDefaults.Content[0].(mcp.TextContent).Text
+			if nodesTopDefaults.IsError {
+				t.Fatalf("call tool failed %s", textContent)
+			}
+			expectedHeaders := regexp.MustCompile(`(?m)^\s*NAME\s+CPU\(cores\)\s+CPU\(%\)\s+MEMORY\(bytes\)\s+MEMORY\(%\)\s*$`)
+			if !expectedHeaders.MatchString(textContent) {
+				t.Errorf("Expected headers '%s' not found in output:\n%s", expectedHeaders.String(), textContent)
+			}
+			expectedRows := []string{
+				"node-1\\s+500m\\s+25%\\s+1024Mi\\s+25%",
+				"node-2\\s+1000m\\s+50%\\s+3072Mi\\s+75%",
+			}
+			for _, row := range expectedRows {
+				if !regexp.MustCompile(row).MatchString(textContent) {
+					t.Errorf("Expected row '%s' not found in output:\n%s", row, textContent)
+				}
+			}
+		})
+		nodesTopSortedLimited, err := c.callTool("nodes_top", map[string]interface{}{
+			"sort_by": "memory",
+			"limit":   1,
+		})
+		t.Run("nodes_top[sort_by=memory,limit=1] returns top node metrics", func(t *testing.T) {
+			if err != nil {
+				t.Fatalf("call tool failed %v", err)
+			}
+			textContent := nodesTopSortedLimited.Content[0].(mcp.TextContent).Text
+			if !regexp.MustCompile(`node-2\s+1000m\s+50%\s+3072Mi\s+75%`).MatchString(textContent) {
+				t.Errorf("Expected node-2 row not found in output:\n%s", textContent)
+			}
+			if regexp.MustCompile(`node-1`).MatchString(textContent) {
+				t.Errorf("Unexpected node-1 row (limit=1) found in output:\n%s", textContent)
+			}
+		})
+		nodesTopName, err := c.callTool("nodes_top", map[string]interface{}{
+			"name": "node-1",
+		})
+		t.Run("nodes_top[name=node-1] returns metrics for provided node", func(t *testing.T) {
+			if err != nil {
+				t.Fatalf("call tool failed %v", err)
+			}
+			textContent := nodesTopName.Content[0].(mcp.TextContent).Text
+			if !regexp.MustCompile(`node-1\s+500m\s+25%\s+1024Mi\s+25%`).MatchString(textContent) {
+				t.Errorf("Expected node-1 row not found in output:\n%s", textContent)
+			}
+			if regexp.MustCompile(`node-2`).MatchString(textContent) {
+				t.Errorf("Unexpected node-2 row found in output:\n%s", textContent)
+			}
+		})
+	})
+}
+
+func TestNodesTopDenied(t *testing.T) {
+	deniedResourcesServer := &config.StaticConfig{DeniedResources: []config.GroupVersionKind{{Group: "metrics.k8s.io", Version: "v1beta1"}}}
+	testCaseWithContext(t, &mcpContext{staticConfig: deniedResourcesServer}, func(c *mcpContext) {
+		mockServer := NewMockServer()
+		defer mockServer.Close()
+		c.withKubeConfig(mockServer.config)
+		mockServer.Handle(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+			w.Header().Set("Content-Type", "application/json")
+			// Request Performed by DiscoveryClient to Kube API (Get API Groups legacy -core-)
+			if req.URL.Path == "/api" {
+				_, _ = w.Write([]byte(`{"kind":"APIVersions","versions":["metrics.k8s.io/v1beta1"],"serverAddressByClientCIDRs":[{"clientCIDR":"0.0.0.0/0"}]}`))
+				return
+			}
+			// Request Performed by DiscoveryClient to Kube API (Get API Groups)
+			if req.URL.Path == "/apis" {
+				_, _ = w.Write([]byte(`{"kind":"APIGroupList","apiVersion":"v1","groups":[]}`))
+				return
+			}
+			// Request Performed by DiscoveryClient to Kube API (Get API Resources)
+			if req.URL.Path == "/apis/metrics.k8s.io/v1beta1" {
+				_, _ = w.Write([]byte(`{"kind":"APIResourceList","apiVersion":"v1","groupVersion":"metrics.k8s.io/v1beta1","resources":[{"name":"nodes","singularName":"","namespaced":false,"kind":"NodeMetrics","verbs":["get","list"]}]}`))
+				return
+			}
+		}))
+		nodesTop, _ := c.callTool("nodes_top", map[string]interface{}{})
+		t.Run("nodes_top has error", func(t *testing.T) {
+			if !nodesTop.IsError {
+				t.Fatalf("call tool should fail")
+			}
+		})
+		t.Run("nodes_top describes denial", func(t *testing.T) {
+			expectedMessage := "failed to get nodes top: resource not allowed: metrics.k8s.io/v1beta1, Kind=NodeMetrics"
+			if nodesTop.Content[0].(mcp.TextContent).Text != expectedMessage {
+				t.Fatalf("expected descriptive error '%s', got %v", expectedMessage, nodesTop.Content[0].(mcp.TextContent).Text)
+			}
+		})
+	})
+}
+
+func TestNodesCordonAndUncordon(t *testing.T) {
+	testCase(t, func(c *mcpContext) {
+		mockServer := NewMockServer()
+		defer mockServer.Close()
+		c.withKubeConfig(mockServer.config)
+		var mu sync.Mutex
+		unschedulable := map[string]bool{"schedulable-node": false, "cordoned-node": true}
+		var patched []string
+		mockServer.Handle(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+			mu.Lock()
+			defer mu.Unlock()
+			name := strings.TrimPrefix(req.URL.Path, "/api/v1/nodes/")
+			if _, ok := unschedulable[name]; !ok {
+				return
+			}
+			if req.Method == http.MethodPatch {
+				body, _ := io.ReadAll(req.Body)
+				patched = append(patched, name+":"+string(body))
+				unschedulable[name] = strings.Contains(string(body), `"unschedulable":true`)
+			}
+			writeObject(w, &v1.Node{
+				TypeMeta:   metav1.TypeMeta{APIVersion: "v1", Kind: "Node"},
+				ObjectMeta: metav1.ObjectMeta{Name: name},
+				Spec:       v1.NodeSpec{Unschedulable: unschedulable[name]},
+			})
+		}))
+		t.Run("nodes_cordon with missing name returns error", func(t *testing.T) {
+			toolResult, _ := c.callTool("nodes_cordon", map[string]interface{}{})
+			if !toolResult.IsError {
+				t.Fatalf("call tool should fail")
+			}
+			if toolResult.Content[0].(mcp.TextContent).Text != "failed to cordon node, missing argument name" {
+				t.Fatalf("invalid error message, got %v", toolResult.Content[0].(mcp.TextContent).Text)
+			}
+		})
+		t.Run("nodes_cordon with schedulable node cordons node", func(t *testing.T) {
+			toolResult, err := c.callTool("nodes_cordon", map[string]interface{}{"name": "schedulable-node"})
+			if err != nil || toolResult.IsError {
+				t.Fatalf("call tool failed %v %v", err, toolResult.Content)
+			}
+			if toolResult.Content[0].(mcp.TextContent).Text != "Node schedulable-node cordoned successfully" {
+				t.Errorf("unexpected result %v", toolResult.Content[0].(mcp.TextContent).Text)
+			}
+			if !unschedulable["schedulable-node"] {
+				t.Errorf("expected node to be unschedulable, patches: %v", patched)
+			}
+		})
+		t.Run("nodes_cordon with cordoned node does nothing", func(t *testing.T) {
+			patched = nil
+			toolResult, err := c.callTool("nodes_cordon", map[string]interface{}{"name": "cordoned-node"})
+			if err != nil || toolResult.IsError {
+				t.Fatalf("call tool failed %v %v", err, toolResult.Content)
+			}
+			if toolResult.Content[0].(mcp.TextContent).Text != "Node cordoned-node is already cordoned" {
+				t.Errorf("unexpected result %v", toolResult.Content[0].(mcp.TextContent).Text)
+			}
+			if len(patched) != 0 {
+				t.Errorf("unexpected patches %v", patched)
+			}
+		})
+		t.Run("nodes_uncordon with cordoned node uncordons node", func(t *testing.T) {
+			toolResult, err := c.callTool("nodes_uncordon", map[string]interface{}{"name": "cordoned-node"})
+			if err != nil || toolResult.IsError {
+				t.Fatalf("call tool failed %v %v", err, toolResult.Content)
+			}
+			if toolResult.Content[0].(mcp.TextContent).Text != "Node cordoned-node uncordoned successfully" {
+				t.Errorf("unexpected result %v", toolResult.Content[0].(mcp.TextContent).Text)
+			}
+			if unschedulable["cordoned-node"] {
+				t.Errorf("expected node to be schedulable, patches: %v", patched)
+			}
+		})
+	})
+}
+
+func TestNodesDrain(t *testing.T) {
+	testCase(t, func(c *mcpContext) {
+		mockServer := NewMockServer()
+		defer mockServer.Close()
+		c.withKubeConfig(mockServer.config)
+		var mu sync.Mutex
+		cordoned := false
+		evicted := map[string]bool{}
+		pod := func(name, ownerKind, ownerName string) v1.Pod {
+			return v1.Pod{
+				TypeMeta: metav1.TypeMeta{APIVersion: "v1", Kind: "Pod"},
+				ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: name, UID: types.UID(name), OwnerReferences: []metav1.OwnerReference{
+					{APIVersion: "apps/v1", Kind: ownerKind, Name: ownerName, Controller: ptr.To(true)},
+				}},
+				Spec:   v1.PodSpec{NodeName: "node-1"},
+				Status: v1.PodStatus{Phase: v1.PodRunning},
+			}
+		}
+		pods := []v1.Pod{pod("web-1", "ReplicaSet", "web"), pod("logs-1", "DaemonSet", "logs")}
+		mockServer.Handle(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+			mu.Lock()
+			defer mu.Unlock()
+			switch req.URL.Path {
+			// Request Performed by DiscoveryClient to Kube API (Get API Resources)
+			case "/api/v1":
+				w.Header().Set("Content-Type", "application/json")
+				_, _ = w.Write([]byte(`{"kind":"APIResourceList","apiVersion":"v1","groupVersion":"v1","resources":[` +
+					`{"name":"pods","singularName":"","namespaced":true,"kind":"Pod","verbs":["get","list"]},` +
+					`{"name":"pods/eviction","singularName":"","namespaced":true,"group":"policy","version":"v1","kind":"Eviction","verbs":["create"]}` +
+					`]}`))
+			case "/api/v1/nodes/node-1":
+				if req.Method == http.MethodPatch {
+					body, _ := io.ReadAll(req.Body)
+					cordoned = strings.Contains(string(body), `"unschedulable":true`)
+				}
+				writeObject(w, &v1.Node{
+					TypeMeta:   metav1.TypeMeta{APIVersion: "v1", Kind: "Node"},
+					ObjectMeta: metav1.ObjectMeta{Name: "node-1"},
+					Spec:       v1.NodeSpec{Unschedulable: cordoned},
+				})
+			case "/api/v1/pods":
+				list := &v1.PodList{TypeMeta: metav1.TypeMeta{APIVersion: "v1", Kind: "PodList"}}
+				for _, p := range pods {
+					if !evicted[p.Name] && req.URL.Query().Get("fieldSelector") == "spec.nodeName=node-1" {
+						list.Items = append(list.Items, p)
+					}
+				}
+				writeObject(w, list)
+			case "/apis/apps/v1/namespaces/default/daemonsets/logs":
+				writeObject(w, &appsv1.DaemonSet{
+					TypeMeta:   metav1.TypeMeta{APIVersion: "apps/v1", Kind: "DaemonSet"},
+					ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "logs"},
+				})
+			case "/api/v1/namespaces/default/pods/web-1/eviction":
+				evicted["web-1"] = true
+				w.Header().Set("Content-Type", "application/json")
+				w.WriteHeader(http.StatusCreated)
+				_, _ = w.Write([]byte(`{"kind":"Status","apiVersion":"v1","status":"Success","code":201}`))
+			case "/api/v1/namespaces/default/pods/web-1":
+				if evicted["web-1"] {
+					w.Header().Set("Content-Type", "application/json")
+					w.WriteHeader(http.StatusNotFound)
+					_, _ = w.Write([]byte(`{"kind":"Status","apiVersion":"v1","status":"Failure","reason":"NotFound","code":404}`))
+					return
+				}
+				writeObject(w, &pods[0])
+			}
+		}))
+		t.Run("nodes_drain with missing name returns error", func(t *testing.T) {
+			toolResult, _ := c.callTool("nodes_drain", map[string]interface{}{})
+			if !toolResult.IsError {
+				t.Fatalf("call tool should fail")
+			}
+			if toolResult.Content[0].(mcp.TextContent).Text != "failed to drain node, missing argument name" {
+				t.Fatalf("invalid error message, got %v", toolResult.Content[0].(mcp.TextContent).Text)
+			}
+		})
+		t.Run("nodes_drain with DaemonSet-managed pods returns error", func(t *testing.T) {
+			toolResult, _ := c.callTool("nodes_drain", map[string]interface{}{"name": "node-1"})
+			if !toolResult.IsError {
+				t.Fatalf("call tool should fail")
+			}
+			if !strings.Contains(toolResult.Content[0].(mcp.TextContent).Text, "cannot delete DaemonSet-managed Pods (use --ignore-daemonsets to ignore): default/logs-1") {
+				t.Errorf("invalid error message, got %v", toolResult.Content[0].(mcp.TextContent).Text)
+			}
+			if len(evicted) != 0 {
+				t.Errorf("unexpected evictions %v", evicted)
+			}
+			if cordoned {
+				t.Errorf("expected node to be uncordoned after the failed drain")
+			}
+		})
+		t.Run("nodes_drain with dryRun reports pods to evict", func(t *testing.T) {
+			cordoned = false
+			toolResult, err := c.callTool("nodes_drain", map[string]interface{}{"name": "node-1", "ignoreDaemonSets": true, "dryRun": true})
+			if err != nil || toolResult.IsError {
+				t.Fatalf("call tool failed %v %v", err, toolResult.Content)
+			}
+			var result kubernetes.NodesDrainResult
+			if err = yaml.Unmarshal([]byte(toolResult.Content[0].(mcp.TextContent).Text), &result); err != nil {
+				t.Fatalf("invalid tool result content %v", err)
+			}
+			if len(result.Pods) != 1 || result.Pods[0] != "default/web-1" {
+				t.Errorf("unexpected pods to evict %v", result.Pods)
+			}
+			if cordoned || len(evicted) != 0 {
+				t.Errorf("unexpected changes with dry run, cordoned: %v, evicted: %v", cordoned, evicted)
+			}
+		})
+		t.Run("nodes_drain with ignoreDaemonSets cordons node and evicts pods", func(t *testing.T) {
+			toolResult, err := c.callTool("nodes_drain", map[string]interface{}{"name": "node-1", "ignoreDaemonSets": true})
+			if err != nil || toolResult.IsError {
+				t.Fatalf("call tool failed %v %v", err, toolResult.Content)
+			}
+			var result kubernetes.NodesDrainResult
+			if err = yaml.Unmarshal([]byte(toolResult.Content[0].(mcp.TextContent).Text), &result); err != nil {
+				t.Fatalf("invalid tool result content %v", err)
+			}
+			if !result.Cordoned || !cordoned {
+				t.Errorf("expected node to be cordoned")
+			}
+			if len(result.Evicted) != 1 || result.Evicted[0] != "default/web-1" || !evicted["web-1"] {
+				t.Errorf("unexpected evicted pods %v", result.Evicted)
+			}
+			if len(result.Blocked) != 0 {
+				t.Errorf("unexpected blocked pods %v", result.Blocked)
+			}
+			if !strings.Contains(result.Warnings, "ignoring DaemonSet-managed Pods: default/logs-1") {
+				t.Errorf("unexpected warnings %v", result.Warnings)
+			}
+		})
+	})
+}
+
+func TestNodesDrainDenied(t *testing.T) {
+	deniedResourcesServer := &config.StaticConfig{DeniedResources: []config.GroupVersionKind{{Version: "v1", Kind: "Pod"}}}
+	testCaseWithContext(t, &mcpContext{staticConfig: deniedResourcesServer}, func(c *mcpContext) {
+		c.withEnvTest()
+		nodesDrain, _ := c.callTool("nodes_drain", map[string]interface{}{"name": "a-node"})
+		t.Run("nodes_drain has error", func(t *testing.T) {
+			if !nodesDrain.IsError {
+				t.Fatalf("call tool should fail")
+			}
+		})
+		t.Run("nodes_drain describes denial", func(t *testing.T) {
+			expectedMessage := "failed to drain node a-node: resource not allowed: /v1, Kind=Pod"
+			if nodesDrain.Content[0].(mcp.TextContent).Text != expectedMessage {
+				t.Fatalf("expected descriptive error '%s', got %v", expectedMessage, nodesDrain.Content[0].(mcp.TextContent).Text)
+			}
+		})
+	})
+}
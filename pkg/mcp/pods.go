@@ -3,12 +3,22 @@ package mcp
 import (
 	"bytes"
 	"context"
+	"crypto/sha256"
+	"encoding/hex"
 	"errors"
 	"fmt"
+	"math"
+	"strconv"
+	"strings"
+	"time"
 
 	"github.com/mark3labs/mcp-go/mcp"
 	"github.com/mark3labs/mcp-go/server"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/kubectl/pkg/metricsutil"
+	"k8s.io/utils/ptr"
 
 	"github.com/manusa/kubernetes-mcp-server/pkg/kubernetes"
 	"github.com/manusa/kubernetes-mcp-server/pkg/output"
@@ -19,6 +29,13 @@ func (s *Server) initPods() []server.ServerTool {
 		{Tool: mcp.NewTool("pods_list",
 			mcp.WithDescription("List all the Kubernetes pods in the current cluster from all namespaces"),
 			mcp.WithString("labelSelector", mcp.Description("Optional Kubernetes label selector (e.g. 'app=myapp,env=prod' or 'app in (myapp,yourapp)'), use this option when you want to filter the pods by label"), mcp.Pattern("([A-Za-z0-9][-A-Za-z0-9_.]*)?[A-Za-z0-9]")),
+			mcp.WithString("fieldSelector", mcp.Description("Optional Kubernetes field selector (e.g. 'status.phase!=Running' or 'spec.nodeName=node-1'), use this option when you want to filter the pods by field")),
+			mcp.WithNumber("limit", mcp.Description("Maximum number of pods to return, use with continue to paginate large result sets (Optional, all pods if not provided)")),
+			mcp.WithString("continue", mcp.Description("Optional continue token returned by a previous call with limit, use it to retrieve the next page of pods")),
+			mcp.WithString("jsonpath", mcp.Description("Optional kubectl JSONPath template to print only the selected values instead of the complete Pod list (e.g. '{.items[*].spec.nodeName}'). Mutually exclusive with fields")),
+			mcp.WithArray("fields", mcp.Description("Optional list of field paths to print only the selected fields of each Pod instead of the complete Pods (e.g. [\"metadata.name\", \"status.phase\", \"spec.containers[*].image\"]). Mutually exclusive with jsonpath"),
+				withStringArrayItems(),
+			),
 			// Tool annotations
 			mcp.WithTitleAnnotation("Pods: List"),
 			mcp.WithReadOnlyHintAnnotation(true),
@@ -29,6 +46,13 @@ func (s *Server) initPods() []server.ServerTool {
 			mcp.WithDescription("List all the Kubernetes pods in the specified namespace in the current cluster"),
 			mcp.WithString("namespace", mcp.Description("Namespace to list pods from"), mcp.Required()),
 			mcp.WithString("labelSelector", mcp.Description("Optional Kubernetes label selector (e.g. 'app=myapp,env=prod' or 'app in (myapp,yourapp)'), use this option when you want to filter the pods by label"), mcp.Pattern("([A-Za-z0-9][-A-Za-z0-9_.]*)?[A-Za-z0-9]")),
+			mcp.WithString("fieldSelector", mcp.Description("Optional Kubernetes field selector (e.g. 'status.phase!=Running' or 'spec.nodeName=node-1'), use this option when you want to filter the pods by field")),
+			mcp.WithNumber("limit", mcp.Description("Maximum number of pods to return, use with continue to paginate large result sets (Optional, all pods if not provided)")),
+			mcp.WithString("continue", mcp.Description("Optional continue token returned by a previous call with limit, use it to retrieve the next page of pods")),
+			mcp.WithString("jsonpath", mcp.Description("Optional kubectl JSONPath template to print only the selected values instead of the complete Pod list (e.g. '{.items[*].spec.nodeName}'). Mutually exclusive with fields")),
+			mcp.WithArray("fields", mcp.Description("Optional list of field paths to print only the selected fields of each Pod instead of the complete Pods (e.g. [\"metadata.name\", \"status.phase\", \"spec.containers[*].image\"]). Mutually exclusive with jsonpath"),
+				withStringArrayItems(),
+			),
 			// Tool annotations
 			mcp.WithTitleAnnotation("Pods: List in Namespace"),
 			mcp.WithReadOnlyHintAnnotation(true),
@@ -39,6 +63,10 @@ func (s *Server) initPods() []server.ServerTool {
 			mcp.WithDescription("Get a Kubernetes Pod in the current or provided namespace with the provided name"),
 			mcp.WithString("namespace", mcp.Description("Namespace to get the Pod from")),
 			mcp.WithString("name", mcp.Description("Name of the Pod"), mcp.Required()),
+			mcp.WithString("jsonpath", mcp.Description("Optional kubectl JSONPath template to print only the selected values instead of the complete Pod (e.g. '{.status.podIP}'). Mutually exclusive with fields")),
+			mcp.WithArray("fields", mcp.Description("Optional list of field paths to print only the selected fields of the Pod instead of the complete Pod (e.g. [\"status.phase\", \"spec.containers[*].image\"]). Mutually exclusive with jsonpath"),
+				withStringArrayItems(),
+			),
 			// Tool annotations
 			mcp.WithTitleAnnotation("Pods: Get"),
 			mcp.WithReadOnlyHintAnnotation(true),
@@ -56,12 +84,29 @@ func (s *Server) initPods() []server.ServerTool {
 			mcp.WithIdempotentHintAnnotation(true),
 			mcp.WithOpenWorldHintAnnotation(true),
 		), Handler: s.podsDelete},
+		{Tool: mcp.NewTool("pods_evict",
+			mcp.WithDescription("Evict a Kubernetes Pod in the current or provided namespace with the provided name using the Eviction API. "+
+				"Unlike pods_delete, the eviction honors the PodDisruptionBudgets of the Pod and is rejected if it would violate any of them. "+
+				"Prefer this tool over pods_delete to safely remove Pods managed by a workload (Deployment, StatefulSet, etc.)"),
+			mcp.WithString("namespace", mcp.Description("Namespace to evict the Pod from")),
+			mcp.WithString("name", mcp.Description("Name of the Pod to evict"), mcp.Required()),
+			mcp.WithNumber("gracePeriodSeconds", mcp.Description("Duration in seconds before the Pod is terminated (Optional, defaults to the Pod's terminationGracePeriodSeconds)")),
+			// Tool annotations
+			mcp.WithTitleAnnotation("Pods: Evict"),
+			mcp.WithReadOnlyHintAnnotation(false),
+			mcp.WithDestructiveHintAnnotation(true),
+			mcp.WithIdempotentHintAnnotation(true),
+			mcp.WithOpenWorldHintAnnotation(true),
+		), Handler: s.podsEvict},
 		{Tool: mcp.NewTool("pods_top",
 			mcp.WithDescription("List the resource consumption (CPU and memory) as recorded by the Kubernetes Metrics Server for the specified Kubernetes Pods in the all namespaces, the provided namespace, or the current namespace"),
 			mcp.WithBoolean("all_namespaces", mcp.Description("If true, list the resource consumption for all Pods in all namespaces. If false, list the resource consumption for Pods in the provided namespace or the current namespace"), mcp.DefaultBool(true)),
 			mcp.WithString("namespace", mcp.Description("Namespace to get the Pods resource consumption from (Optional, current namespace if not provided and all_namespaces is false)")),
 			mcp.WithString("name", mcp.Description("Name of the Pod to get the resource consumption from (Optional, all Pods in the namespace if not provided)")),
 			mcp.WithString("label_selector", mcp.Description("Kubernetes label selector (e.g. 'app=myapp,env=prod' or 'app in (myapp,yourapp)'), use this option when you want to filter the pods by label (Optional, only applicable when name is not provided)"), mcp.Pattern("([A-Za-z0-9][-A-Za-z0-9_.]*)?[A-Za-z0-9]")),
+			mcp.WithBoolean("containers", mcp.Description("If true, list the resource consumption of each container of the Pods. If false, list the aggregated resource consumption of each Pod"), mcp.DefaultBool(true)),
+			mcp.WithString("sort_by", mcp.Description("Sort the Pods by their resource consumption in descending order (Optional, sorted by namespace and name if not provided)"), mcp.Enum("cpu", "memory")),
+			mcp.WithNumber("limit", mcp.Description("Maximum number of Pods to return after sorting, use with sort_by to find the top consumers (Optional, all Pods if not provided)")),
 			// Tool annotations
 			mcp.WithTitleAnnotation("Pods: Top"),
 			mcp.WithReadOnlyHintAnnotation(true),
@@ -70,23 +115,19 @@ func (s *Server) initPods() []server.ServerTool {
 			mcp.WithOpenWorldHintAnnotation(true),
 		), Handler: s.podsTop},
 		{Tool: mcp.NewTool("pods_exec",
-			mcp.WithDescription("Execute a command in a Kubernetes Pod in the current or provided namespace with the provided name and command"),
+			mcp.WithDescription("Execute a command in a Kubernetes Pod in the current or provided namespace with the provided name and command. "+
+				"If the command exits with a non-zero code or writes to stderr, the result includes the exit code and both the stdout and stderr output"),
 			mcp.WithString("namespace", mcp.Description("Namespace of the Pod where the command will be executed")),
 			mcp.WithString("name", mcp.Description("Name of the Pod where the command will be executed"), mcp.Required()),
 			mcp.WithArray("command", mcp.Description("Command to execute in the Pod container. "+
 				"The first item is the command to be run, and the rest are the arguments to that command. "+
 				`Example: ["ls", "-l", "/tmp"]`),
-				// TODO: manual fix to ensure that the items property gets initialized (Gemini)
-				// https://www.googlecloudcommunity.com/gc/AI-ML/Gemini-API-400-Bad-Request-Array-fields-breaks-function-calling/m-p/769835?nobounce
-				func(schema map[string]interface{}) {
-					schema["type"] = "array"
-					schema["items"] = map[string]interface{}{
-						"type": "string",
-					}
-				},
+				withStringArrayItems(),
 				mcp.Required(),
 			),
 			mcp.WithString("container", mcp.Description("Name of the Pod container where the command will be executed (Optional)")),
+			mcp.WithString("stdin", mcp.Description("Content to send to the standard input of the command (Optional)")),
+			mcp.WithString("timeout", mcp.Description("Maximum duration of the command execution like 30s or 5m (Optional, no timeout if not provided)")),
 			// Tool annotations
 			mcp.WithTitleAnnotation("Pods: Exec"),
 			mcp.WithReadOnlyHintAnnotation(false),
@@ -94,23 +135,177 @@ func (s *Server) initPods() []server.ServerTool {
 			mcp.WithIdempotentHintAnnotation(false),
 			mcp.WithOpenWorldHintAnnotation(true),
 		), Handler: s.podsExec},
+		{Tool: mcp.NewTool("pods_debug",
+			mcp.WithDescription("Debug a running Kubernetes Pod in the current or provided namespace by adding an ephemeral container with the provided image (kubectl debug). "+
+				"Useful for Pods with distroless images where pods_exec can't be used. "+
+				"Waits for the ephemeral container to start and optionally executes a command in it"),
+			mcp.WithString("namespace", mcp.Description("Namespace of the Pod to debug")),
+			mcp.WithString("name", mcp.Description("Name of the Pod to debug"), mcp.Required()),
+			mcp.WithString("image", mcp.Description("Container image of the ephemeral debug container, must provide a shell (e.g. busybox)"), mcp.Required()),
+			mcp.WithString("container", mcp.Description("Name of the ephemeral debug container (Optional, random name if not provided)")),
+			mcp.WithString("target", mcp.Description("Name of the Pod container to share the process namespace with (Optional)")),
+			mcp.WithArray("command", mcp.Description("Command to execute in the ephemeral debug container once started (Optional). "+
+				`Example: ["ps", "aux"]`),
+				withStringArrayItems(),
+			),
+			mcp.WithString("timeout", mcp.Description("Maximum duration to wait for the ephemeral container to start and for the command to complete like 30s or 5m (Optional, defaults to 2m)")),
+			// Tool annotations
+			mcp.WithTitleAnnotation("Pods: Debug"),
+			mcp.WithReadOnlyHintAnnotation(false),
+			mcp.WithDestructiveHintAnnotation(false), // Ephemeral containers can't be removed, but they don't affect the running containers
+			mcp.WithIdempotentHintAnnotation(false),
+			mcp.WithOpenWorldHintAnnotation(true),
+		), Handler: s.podsDebug},
+		{Tool: mcp.NewTool("pods_cp_from",
+			mcp.WithDescription("Copy a file or a directory (recursively) from a Kubernetes Pod container in the current or provided namespace and return the content of its files. "+
+				"Text files are returned as is, binary files are returned base64 encoded. The archive read from the container is limited to 10MiB. Requires the tar binary in the container"),
+			mcp.WithString("namespace", mcp.Description("Namespace of the Pod to copy the files from")),
+			mcp.WithString("name", mcp.Description("Name of the Pod to copy the files from"), mcp.Required()),
+			mcp.WithString("container", mcp.Description("Name of the Pod container to copy the files from (Optional)")),
+			mcp.WithString("path", mcp.Description("Path of the file or directory in the container to copy (e.g. /etc/nginx/nginx.conf)"), mcp.Required()),
+			// Tool annotations
+			mcp.WithTitleAnnotation("Pods: Copy From"),
+			mcp.WithReadOnlyHintAnnotation(false), // Executes the tar command in the container
+			mcp.WithDestructiveHintAnnotation(false),
+			mcp.WithOpenWorldHintAnnotation(true),
+		), Handler: s.podsCpFrom},
+		{Tool: mcp.NewTool("pods_cp_to",
+			mcp.WithDescription("Copy files with the provided content into a Kubernetes Pod container in the current or provided namespace, existing files are overwritten. "+
+				"Requires the tar binary in the container"),
+			mcp.WithString("namespace", mcp.Description("Namespace of the Pod to copy the files to")),
+			mcp.WithString("name", mcp.Description("Name of the Pod to copy the files to"), mcp.Required()),
+			mcp.WithString("container", mcp.Description("Name of the Pod container to copy the files to (Optional)")),
+			mcp.WithArray("files", mcp.Description("Files to copy into the container. "+
+				`Example: [{"path": "/tmp/config.yaml", "content": "key: value"}, {"path": "/tmp/data.bin", "content": "AAEC", "encoding": "base64"}]`),
+				func(schema map[string]interface{}) {
+					schema["type"] = "array"
+					schema["items"] = map[string]interface{}{
+						"type": "object",
+						"properties": map[string]interface{}{
+							"path":     map[string]interface{}{"type": "string", "description": "Absolute path of the file in the container"},
+							"content":  map[string]interface{}{"type": "string", "description": "Content of the file"},
+							"encoding": map[string]interface{}{"type": "string", "description": "Encoding of the content (Optional, text or base64, defaults to text)", "enum": []string{"text", "base64"}},
+						},
+						"required": []string{"path", "content"},
+					}
+				},
+				mcp.Required(),
+			),
+			// Tool annotations
+			mcp.WithTitleAnnotation("Pods: Copy To"),
+			mcp.WithReadOnlyHintAnnotation(false),
+			mcp.WithDestructiveHintAnnotation(true),
+			mcp.WithIdempotentHintAnnotation(true),
+			mcp.WithOpenWorldHintAnnotation(true),
+		), Handler: s.podsCpTo},
+		{Tool: mcp.NewTool("pods_diagnose",
+			mcp.WithDescription("Diagnose a Kubernetes Pod in the current or provided namespace with the provided name in a single call. "+
+				"Returns a troubleshooting report with the findings (scheduling failures, image pull errors, OOMKilled or failing containers, restarts, node problems), "+
+				"the Pod phase and conditions, the state and last termination of each container, the warning events of the Pod, "+
+				"the conditions of the node where the Pod is scheduled, and the tail of the previous logs of restarting containers"),
+			mcp.WithString("namespace", mcp.Description("Namespace of the Pod to diagnose")),
+			mcp.WithString("name", mcp.Description("Name of the Pod to diagnose"), mcp.Required()),
+			mcp.WithNumber("tail", mcp.Description("Number of lines of the previous logs to retrieve for restarting containers (Optional, defaults to 20)")),
+			// Tool annotations
+			mcp.WithTitleAnnotation("Pods: Diagnose"),
+			mcp.WithReadOnlyHintAnnotation(true),
+			mcp.WithDestructiveHintAnnotation(false),
+			mcp.WithOpenWorldHintAnnotation(true),
+		), Handler: s.podsDiagnose},
 		{Tool: mcp.NewTool("pods_log",
 			mcp.WithDescription("Get the logs of a Kubernetes Pod in the current or provided namespace with the provided name"),
 			mcp.WithString("namespace", mcp.Description("Namespace to get the Pod logs from")),
 			mcp.WithString("name", mcp.Description("Name of the Pod to get the logs from"), mcp.Required()),
 			mcp.WithString("container", mcp.Description("Name of the Pod container to get the logs from (Optional)")),
+			mcp.WithBoolean("previous", mcp.Description("Return the logs of the previous terminated container instance, useful to debug crash-looping containers (Optional)")),
+			mcp.WithNumber("tail", mcp.Description("Number of lines from the end of the logs to return (Optional, 256 if none of tail, since, sinceTime, or limitBytes are provided)")),
+			mcp.WithString("since", mcp.Description("Only return logs newer than a relative duration like 5s, 10m, or 3h (Optional, mutually exclusive with sinceTime)")),
+			mcp.WithString("sinceTime", mcp.Description("Only return logs after the provided RFC3339 timestamp (e.g. 2025-01-01T10:00:00Z) (Optional, mutually exclusive with since)")),
+			mcp.WithBoolean("timestamps", mcp.Description("Prefix each log line with its RFC3339 timestamp (Optional)")),
+			mcp.WithNumber("limitBytes", mcp.Description("Maximum number of bytes of logs to return (Optional)")),
 			// Tool annotations
 			mcp.WithTitleAnnotation("Pods: Log"),
 			mcp.WithReadOnlyHintAnnotation(true),
 			mcp.WithDestructiveHintAnnotation(false),
 			mcp.WithOpenWorldHintAnnotation(true),
 		), Handler: s.podsLog},
+		{Tool: mcp.NewTool("pods_log_selector",
+			mcp.WithDescription("Get the aggregated logs of all the containers of the Kubernetes Pods matching a label selector or owned by a workload (Deployment, StatefulSet, DaemonSet, ReplicaSet, or Job) in the current or provided namespace. "+
+				"Logs from all replicas are merged by timestamp and each line is prefixed with [pod/container]"),
+			mcp.WithString("namespace", mcp.Description("Namespace to get the Pods logs from")),
+			mcp.WithString("labelSelector", mcp.Description("Kubernetes label selector (e.g. 'app=myapp,env=prod' or 'app in (myapp,yourapp)') of the Pods to get the logs from (Optional, required if kind and name are not provided)"), mcp.Pattern("([A-Za-z0-9][-A-Za-z0-9_.]*)?[A-Za-z0-9]")),
+			mcp.WithString("kind", mcp.Description("Kind of the workload whose Pods logs should be retrieved (Optional, one of Deployment, StatefulSet, DaemonSet, ReplicaSet, Job)")),
+			mcp.WithString("name", mcp.Description("Name of the workload whose Pods logs should be retrieved (Optional, required if kind is provided)")),
+			mcp.WithString("container", mcp.Description("Name of the container to get the logs from (Optional, all containers if not provided)")),
+			mcp.WithBoolean("previous", mcp.Description("Return the logs of the previous terminated container instances (Optional)")),
+			mcp.WithNumber("tail", mcp.Description("Number of lines from the end of the logs of each container to return (Optional, 256 if none of tail, since, sinceTime, or limitBytes are provided)")),
+			mcp.WithString("since", mcp.Description("Only return logs newer than a relative duration like 5s, 10m, or 3h (Optional, mutually exclusive with sinceTime)")),
+			mcp.WithString("sinceTime", mcp.Description("Only return logs after the provided RFC3339 timestamp (e.g. 2025-01-01T10:00:00Z) (Optional, mutually exclusive with since)")),
+			mcp.WithBoolean("timestamps", mcp.Description("Keep the RFC3339 timestamp of each log line in the output (Optional)")),
+			mcp.WithNumber("limitBytes", mcp.Description("Maximum number of bytes of logs to return for each container (Optional)")),
+			// Tool annotations
+			mcp.WithTitleAnnotation("Pods: Log (Selector)"),
+			mcp.WithReadOnlyHintAnnotation(true),
+			mcp.WithDestructiveHintAnnotation(false),
+			mcp.WithOpenWorldHintAnnotation(true),
+		), Handler: s.podsLogSelector},
+		{Tool: mcp.NewTool("pods_port_forward_start",
+			mcp.WithDescription("Start forwarding a local port on the MCP server host to a port of a Kubernetes Pod or Service in the current or provided namespace. "+
+				"The session keeps running in the background until it's stopped with pods_port_forward_stop, the MCP session is closed, or the MCP server is closed. "+
+				"Returns the session id and the bound local port (on 127.0.0.1)"),
+			mcp.WithString("namespace", mcp.Description("Namespace of the Pod or Service")),
+			mcp.WithString("name", mcp.Description("Name of the Pod to forward the port to (Optional, required if service is not provided)")),
+			mcp.WithString("service", mcp.Description("Name of the Service to forward the port to, a running Pod backing the Service will be selected (Optional, required if name is not provided)")),
+			mcp.WithNumber("remotePort", mcp.Description("Pod container port, or Service port if a service is provided, to forward to (Optional only for Services exposing a single port)")),
+			mcp.WithNumber("localPort", mcp.Description("Local port to listen on (Optional, random port if not provided)")),
+			// Tool annotations
+			mcp.WithTitleAnnotation("Pods: Port Forward Start"),
+			mcp.WithReadOnlyHintAnnotation(false),
+			mcp.WithDestructiveHintAnnotation(false),
+			mcp.WithIdempotentHintAnnotation(false),
+			mcp.WithOpenWorldHintAnnotation(true),
+		), Handler: s.podsPortForwardStart},
+		{Tool: mcp.NewTool("pods_port_forward_list",
+			mcp.WithDescription("List the active port-forward sessions started with pods_port_forward_start in the current MCP session"),
+			// Tool annotations
+			mcp.WithTitleAnnotation("Pods: Port Forward List"),
+			mcp.WithReadOnlyHintAnnotation(true),
+			mcp.WithDestructiveHintAnnotation(false),
+			mcp.WithOpenWorldHintAnnotation(false),
+		), Handler: s.podsPortForwardList},
+		{Tool: mcp.NewTool("pods_port_forward_stop",
+			mcp.WithDescription("Stop an active port-forward session started with pods_port_forward_start"),
+			mcp.WithString("id", mcp.Description("Id of the port-forward session to stop"), mcp.Required()),
+			// Tool annotations
+			mcp.WithTitleAnnotation("Pods: Port Forward Stop"),
+			mcp.WithReadOnlyHintAnnotation(false),
+			mcp.WithDestructiveHintAnnotation(false),
+			mcp.WithIdempotentHintAnnotation(true),
+			mcp.WithOpenWorldHintAnnotation(false),
+		), Handler: s.podsPortForwardStop},
 		{Tool: mcp.NewTool("pods_run",
 			mcp.WithDescription("Run a Kubernetes Pod in the current or provided namespace with the provided container image and optional name"),
 			mcp.WithString("namespace", mcp.Description("Namespace to run the Pod in")),
 			mcp.WithString("name", mcp.Description("Name of the Pod (Optional, random name if not provided)")),
 			mcp.WithString("image", mcp.Description("Container Image to run in the Pod"), mcp.Required()),
 			mcp.WithNumber("port", mcp.Description("TCP/IP port to expose from the Pod container (Optional, no port exposed if not provided)")),
+			mcp.WithArray("command", mcp.Description("Entrypoint of the container, overrides the image ENTRYPOINT (Optional). "+
+				`Example: ["sh", "-c"]`),
+				withStringArrayItems(),
+			),
+			mcp.WithArray("args", mcp.Description("Arguments of the container entrypoint, overrides the image CMD (Optional). "+
+				`Example: ["sleep 3600"]`),
+				withStringArrayItems(),
+			),
+			mcp.WithObject("env", mcp.Description(`Environment variables of the container (Optional). Example: {"LOG_LEVEL": "debug"}`)),
+			mcp.WithObject("requests", mcp.Description(`Resource requests of the container (Optional). Example: {"cpu": "100m", "memory": "128Mi"}`)),
+			mcp.WithObject("limits", mcp.Description(`Resource limits of the container (Optional). Example: {"cpu": "500m", "memory": "256Mi"}`)),
+			mcp.WithString("imagePullPolicy", mcp.Description("Image pull policy of the container (Optional, defaults to Always)"), mcp.Enum("Always", "IfNotPresent", "Never")),
+			mcp.WithString("restartPolicy", mcp.Description("Restart policy of the Pod (Optional, defaults to Always)"), mcp.Enum("Always", "OnFailure", "Never")),
+			mcp.WithObject("labels", mcp.Description(`Additional labels for the Pod (Optional). Example: {"team": "sre"}`)),
+			mcp.WithString("serviceAccount", mcp.Description("Name of the ServiceAccount to run the Pod with (Optional)")),
+			mcp.WithBoolean("wait", mcp.Description("Wait until the Pod is Running and Ready, has completed, or has failed (e.g. image pull errors) and report its final phase and container states (Optional)")),
+			mcp.WithString("waitTimeout", mcp.Description("Maximum duration to wait for the Pod like 30s or 5m (Optional, defaults to 2m, only applicable when wait is true)")),
 			// Tool annotations
 			mcp.WithTitleAnnotation("Pods: Run"),
 			mcp.WithReadOnlyHintAnnotation(false),
@@ -122,12 +317,13 @@ func (s *Server) initPods() []server.ServerTool {
 }
 
 func (s *Server) podsListInAllNamespaces(ctx context.Context, ctr mcp.CallToolRequest) (*mcp.CallToolResult, error) {
-	labelSelector := ctr.GetArguments()["labelSelector"]
-	resourceListOptions := kubernetes.ResourceListOptions{
-		AsTable: s.configuration.ListOutput.AsTable(),
+	p, err := parseProjection(ctr.GetArguments())
+	if err != nil {
+		return NewTextResult("", fmt.Errorf("failed to list pods in all namespaces, %s", err)), nil
 	}
-	if labelSelector != nil {
-		resourceListOptions.LabelSelector = labelSelector.(string)
+	resourceListOptions, err := s.resourceListOptions(ctr.GetArguments())
+	if err != nil {
+		return NewTextResult("", fmt.Errorf("failed to list pods in all namespaces, %s", err)), nil
 	}
 	derived, err := s.k.Derived(ctx)
 	if err != nil {
@@ -137,7 +333,7 @@ func (s *Server) podsListInAllNamespaces(ctx context.Context, ctr mcp.CallToolRe
 	if err != nil {
 		return NewTextResult("", fmt.Errorf("failed to list pods in all namespaces: %v", err)), nil
 	}
-	return NewTextResult(s.configuration.ListOutput.PrintObj(ret)), nil
+	return NewTextResult(s.printList(ret, p)), nil
 }
 
 func (s *Server) podsListInNamespace(ctx context.Context, ctr mcp.CallToolRequest) (*mcp.CallToolResult, error) {
@@ -145,12 +341,13 @@ func (s *Server) podsListInNamespace(ctx context.Context, ctr mcp.CallToolReques
 	if ns == nil {
 		return NewTextResult("", errors.New("failed to list pods in namespace, missing argument namespace")), nil
 	}
-	resourceListOptions := kubernetes.ResourceListOptions{
-		AsTable: s.configuration.ListOutput.AsTable(),
+	p, err := parseProjection(ctr.GetArguments())
+	if err != nil {
+		return NewTextResult("", fmt.Errorf("failed to list pods in namespace %s, %s", ns, err)), nil
 	}
-	labelSelector := ctr.GetArguments()["labelSelector"]
-	if labelSelector != nil {
-		resourceListOptions.LabelSelector = labelSelector.(string)
+	resourceListOptions, err := s.resourceListOptions(ctr.GetArguments())
+	if err != nil {
+		return NewTextResult("", fmt.Errorf("failed to list pods in namespace %s, %s", ns, err)), nil
 	}
 	derived, err := s.k.Derived(ctx)
 	if err != nil {
@@ -160,7 +357,7 @@ func (s *Server) podsListInNamespace(ctx context.Context, ctr mcp.CallToolReques
 	if err != nil {
 		return NewTextResult("", fmt.Errorf("failed to list pods in namespace %s: %v", ns, err)), nil
 	}
-	return NewTextResult(s.configuration.ListOutput.PrintObj(ret)), nil
+	return NewTextResult(s.printList(ret, p)), nil
 }
 
 func (s *Server) podsGet(ctx context.Context, ctr mcp.CallToolRequest) (*mcp.CallToolResult, error) {
@@ -172,6 +369,10 @@ func (s *Server) podsGet(ctx context.Context, ctr mcp.CallToolRequest) (*mcp.Cal
 	if name == nil {
 		return NewTextResult("", errors.New("failed to get pod, missing argument name")), nil
 	}
+	p, err := parseProjection(ctr.GetArguments())
+	if err != nil {
+		return NewTextResult("", fmt.Errorf("failed to get pod %s in namespace %s, %s", name, ns, err)), nil
+	}
 	derived, err := s.k.Derived(ctx)
 	if err != nil {
 		return nil, err
@@ -180,7 +381,7 @@ func (s *Server) podsGet(ctx context.Context, ctr mcp.CallToolRequest) (*mcp.Cal
 	if err != nil {
 		return NewTextResult("", fmt.Errorf("failed to get pod %s in namespace %s: %v", name, ns, err)), nil
 	}
-	return NewTextResult(output.MarshalYaml(ret)), nil
+	return NewTextResult(printObject(ret, p)), nil
 }
 
 func (s *Server) podsDelete(ctx context.Context, ctr mcp.CallToolRequest) (*mcp.CallToolResult, error) {
@@ -203,6 +404,30 @@ func (s *Server) podsDelete(ctx context.Context, ctr mcp.CallToolRequest) (*mcp.
 	return NewTextResult(ret, err), nil
 }
 
+func (s *Server) podsEvict(ctx context.Context, ctr mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	ns := ""
+	if v, ok := ctr.GetArguments()["namespace"].(string); ok {
+		ns = v
+	}
+	name, ok := ctr.GetArguments()["name"].(string)
+	if !ok || name == "" {
+		return NewTextResult("", errors.New("failed to evict pod, missing argument name")), nil
+	}
+	var gracePeriodSeconds *int64
+	if v, ok := ctr.GetArguments()["gracePeriodSeconds"].(float64); ok {
+		gracePeriodSeconds = ptr.To(int64(v))
+	}
+	derived, err := s.k.Derived(ctx)
+	if err != nil {
+		return nil, err
+	}
+	ret, err := derived.PodsEvict(ctx, ns, name, gracePeriodSeconds)
+	if err != nil {
+		return NewTextResult("", fmt.Errorf("failed to evict pod %s in namespace %s: %v", name, ns, err)), nil
+	}
+	return NewTextResult(ret, nil), nil
+}
+
 func (s *Server) podsTop(ctx context.Context, ctr mcp.CallToolRequest) (*mcp.CallToolResult, error) {
 	podsTopOptions := kubernetes.PodsTopOptions{AllNamespaces: true}
 	if v, ok := ctr.GetArguments()["namespace"].(string); ok {
@@ -217,6 +442,16 @@ func (s *Server) podsTop(ctx context.Context, ctr mcp.CallToolRequest) (*mcp.Cal
 	if v, ok := ctr.GetArguments()["label_selector"].(string); ok {
 		podsTopOptions.LabelSelector = v
 	}
+	if v, ok := ctr.GetArguments()["sort_by"].(string); ok {
+		podsTopOptions.SortBy = v
+	}
+	if v, ok := ctr.GetArguments()["limit"].(float64); ok {
+		podsTopOptions.Limit = int(v)
+	}
+	containers := true
+	if v, ok := ctr.GetArguments()["containers"].(bool); ok {
+		containers = v
+	}
 	derived, err := s.k.Derived(ctx)
 	if err != nil {
 		return nil, err
@@ -227,7 +462,7 @@ func (s *Server) podsTop(ctx context.Context, ctr mcp.CallToolRequest) (*mcp.Cal
 	}
 	buf := new(bytes.Buffer)
 	printer := metricsutil.NewTopCmdPrinter(buf)
-	err = printer.PrintPodMetrics(ret.Items, true, true, false, "", true)
+	err = printer.PrintPodMetrics(ret.Items, containers, true, false, podsTopOptions.SortBy, true)
 	if err != nil {
 		return NewTextResult("", fmt.Errorf("failed to get pods top: %v", err)), nil
 	}
@@ -243,32 +478,235 @@ func (s *Server) podsExec(ctx context.Context, ctr mcp.CallToolRequest) (*mcp.Ca
 	if name == nil {
 		return NewTextResult("", errors.New("failed to exec in pod, missing argument name")), nil
 	}
-	container := ctr.GetArguments()["container"]
-	if container == nil {
-		container = ""
+	podsExecOptions := kubernetes.PodsExecOptions{}
+	if v, ok := ctr.GetArguments()["container"].(string); ok {
+		podsExecOptions.Container = v
 	}
 	commandArg := ctr.GetArguments()["command"]
-	command := make([]string, 0)
 	if _, ok := commandArg.([]interface{}); ok {
 		for _, cmd := range commandArg.([]interface{}) {
 			if _, ok := cmd.(string); ok {
-				command = append(command, cmd.(string))
+				podsExecOptions.Command = append(podsExecOptions.Command, cmd.(string))
 			}
 		}
 	} else {
 		return NewTextResult("", errors.New("failed to exec in pod, invalid command argument")), nil
 	}
+	if v, ok := ctr.GetArguments()["stdin"].(string); ok && v != "" {
+		podsExecOptions.Stdin = strings.NewReader(v)
+	}
+	if v, ok := ctr.GetArguments()["timeout"].(string); ok && v != "" {
+		timeout, err := time.ParseDuration(v)
+		if err != nil || timeout <= 0 {
+			return NewTextResult("", fmt.Errorf("failed to exec in pod, invalid argument timeout: %s", v)), nil
+		}
+		podsExecOptions.Timeout = timeout
+	}
 	derived, err := s.k.Derived(ctx)
 	if err != nil {
 		return nil, err
 	}
-	ret, err := derived.PodsExec(ctx, ns.(string), name.(string), container.(string), command)
+	ret, err := derived.PodsExec(ctx, ns.(string), name.(string), podsExecOptions)
+	// Partial output (e.g. timeout) is reported along with the error
+	if err != nil && ret != nil && (ret.Stdout != "" || ret.Stderr != "") {
+		sb := strings.Builder{}
+		sb.WriteString(fmt.Sprintf("failed to exec in pod %s in namespace %s: %v, output before the failure:\n", name, ns, err))
+		printExecResult(&sb, ret)
+		return NewTextResult("", errors.New(sb.String())), nil
+	}
 	if err != nil {
 		return NewTextResult("", fmt.Errorf("failed to exec in pod %s in namespace %s: %v", name, ns, err)), nil
-	} else if ret == "" {
-		ret = fmt.Sprintf("The executed command in pod %s in namespace %s has not produced any output", name, ns)
 	}
-	return NewTextResult(ret, err), nil
+	// Keep the plain output for the most common case (successful command without stderr output)
+	if ret.ExitCode == 0 && ret.Stderr == "" {
+		if ret.Stdout == "" {
+			return NewTextResult(fmt.Sprintf("The executed command in pod %s in namespace %s has not produced any output", name, ns), nil), nil
+		}
+		return NewTextResult(ret.Stdout, nil), nil
+	}
+	sb := strings.Builder{}
+	sb.WriteString(fmt.Sprintf("The executed command in pod %s in namespace %s exited with code %d\n", name, ns, ret.ExitCode))
+	printExecResult(&sb, ret)
+	return NewTextResult(sb.String(), nil), nil
+}
+
+// printExecResult writes the stdout and stderr sections of the executed command
+func printExecResult(sb *strings.Builder, ret *kubernetes.PodsExecResult) {
+	sb.WriteString("--- stdout ---\n")
+	sb.WriteString(ret.Stdout)
+	if ret.Stdout != "" && !strings.HasSuffix(ret.Stdout, "\n") {
+		sb.WriteString("\n")
+	}
+	sb.WriteString("--- stderr ---\n")
+	sb.WriteString(ret.Stderr)
+}
+
+func (s *Server) podsDebug(ctx context.Context, ctr mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	ns := ""
+	if v, ok := ctr.GetArguments()["namespace"].(string); ok {
+		ns = v
+	}
+	name, ok := ctr.GetArguments()["name"].(string)
+	if !ok || name == "" {
+		return NewTextResult("", errors.New("failed to debug pod, missing argument name")), nil
+	}
+	podsDebugOptions := kubernetes.PodsDebugOptions{Command: stringArray(ctr.GetArguments()["command"])}
+	if podsDebugOptions.Image, ok = ctr.GetArguments()["image"].(string); !ok || podsDebugOptions.Image == "" {
+		return NewTextResult("", errors.New("failed to debug pod, missing argument image")), nil
+	}
+	if v, ok := ctr.GetArguments()["container"].(string); ok {
+		podsDebugOptions.Container = v
+	}
+	if v, ok := ctr.GetArguments()["target"].(string); ok {
+		podsDebugOptions.TargetContainer = v
+	}
+	if v, ok := ctr.GetArguments()["timeout"].(string); ok && v != "" {
+		timeout, err := time.ParseDuration(v)
+		if err != nil || timeout <= 0 {
+			return NewTextResult("", fmt.Errorf("failed to debug pod, invalid argument timeout: %s", v)), nil
+		}
+		podsDebugOptions.Timeout = timeout
+	}
+	derived, err := s.k.Derived(ctx)
+	if err != nil {
+		return nil, err
+	}
+	ret, err := derived.PodsDebug(ctx, ns, name, podsDebugOptions)
+	if err != nil && ret == nil {
+		return NewTextResult("", fmt.Errorf("failed to debug pod %s in namespace %s: %v", name, ns, err)), nil
+	}
+	marshalledYaml, mErr := output.MarshalYaml(ret.Container)
+	if mErr != nil {
+		return NewTextResult("", fmt.Errorf("failed to debug pod: %v", mErr)), nil
+	}
+	sb := strings.Builder{}
+	// The ephemeral container can't be removed, it's reported even if the command failed so that it can be reused
+	if err != nil {
+		sb.WriteString(fmt.Sprintf("failed to debug pod %s in namespace %s: %v\n", name, ns, err))
+	}
+	sb.WriteString(fmt.Sprintf("# The following ephemeral container (YAML) has been added to pod %s in namespace %s\n", name, ns))
+	sb.WriteString(marshalledYaml)
+	switch {
+	case err != nil && ret.Exec != nil && (ret.Exec.Stdout != "" || ret.Exec.Stderr != ""):
+		sb.WriteString("# Output of the executed command before the failure\n")
+		printExecResult(&sb, ret.Exec)
+	case ret.Exec != nil && err == nil:
+		sb.WriteString(fmt.Sprintf("# The executed command exited with code %d\n", ret.Exec.ExitCode))
+		printExecResult(&sb, ret.Exec)
+	}
+	if err != nil {
+		return NewTextResult("", errors.New(sb.String())), nil
+	}
+	return NewTextResult(sb.String(), nil), nil
+}
+
+func (s *Server) podsCpFrom(ctx context.Context, ctr mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	ns := ""
+	if v, ok := ctr.GetArguments()["namespace"].(string); ok {
+		ns = v
+	}
+	name, ok := ctr.GetArguments()["name"].(string)
+	if !ok || name == "" {
+		return NewTextResult("", errors.New("failed to copy from pod, missing argument name")), nil
+	}
+	container := ""
+	if v, ok := ctr.GetArguments()["container"].(string); ok {
+		container = v
+	}
+	path, ok := ctr.GetArguments()["path"].(string)
+	if !ok || path == "" {
+		return NewTextResult("", errors.New("failed to copy from pod, missing argument path")), nil
+	}
+	derived, err := s.k.Derived(ctx)
+	if err != nil {
+		return nil, err
+	}
+	files, err := derived.PodsCpFrom(ctx, ns, name, container, path)
+	if err != nil {
+		return NewTextResult("", fmt.Errorf("failed to copy %s from pod %s in namespace %s: %v", path, name, ns, err)), nil
+	}
+	if len(files) == 0 {
+		return NewTextResult(fmt.Sprintf("No regular files found in %s in pod %s in namespace %s", path, name, ns), nil), nil
+	}
+	marshalledYaml, err := output.MarshalYaml(files)
+	if err != nil {
+		err = fmt.Errorf("failed to copy from pod: %v", err)
+	}
+	return NewTextResult("# The following files (YAML) have been copied from the pod\n"+marshalledYaml, err), nil
+}
+
+func (s *Server) podsCpTo(ctx context.Context, ctr mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	ns := ""
+	if v, ok := ctr.GetArguments()["namespace"].(string); ok {
+		ns = v
+	}
+	name, ok := ctr.GetArguments()["name"].(string)
+	if !ok || name == "" {
+		return NewTextResult("", errors.New("failed to copy to pod, missing argument name")), nil
+	}
+	container := ""
+	if v, ok := ctr.GetArguments()["container"].(string); ok {
+		container = v
+	}
+	filesArg, ok := ctr.GetArguments()["files"].([]interface{})
+	if !ok || len(filesArg) == 0 {
+		return NewTextResult("", errors.New("failed to copy to pod, missing argument files")), nil
+	}
+	files := make([]kubernetes.PodsCpFile, 0, len(filesArg))
+	for _, f := range filesArg {
+		fileArg, ok := f.(map[string]interface{})
+		if !ok {
+			return NewTextResult("", errors.New("failed to copy to pod, invalid files argument")), nil
+		}
+		file := kubernetes.PodsCpFile{}
+		file.Path, _ = fileArg["path"].(string)
+		file.Content, _ = fileArg["content"].(string)
+		file.Encoding, _ = fileArg["encoding"].(string)
+		if file.Path == "" {
+			return NewTextResult("", errors.New("failed to copy to pod, missing file path")), nil
+		}
+		files = append(files, file)
+	}
+	derived, err := s.k.Derived(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if err = derived.PodsCpTo(ctx, ns, name, container, files); err != nil {
+		return NewTextResult("", fmt.Errorf("failed to copy files to pod %s in namespace %s: %v", name, ns, err)), nil
+	}
+	paths := make([]string, 0, len(files))
+	for _, file := range files {
+		paths = append(paths, file.Path)
+	}
+	return NewTextResult(fmt.Sprintf("Files copied successfully to pod %s in namespace %s: %s", name, ns, strings.Join(paths, ", ")), nil), nil
+}
+
+func (s *Server) podsDiagnose(ctx context.Context, ctr mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	ns := ""
+	if v, ok := ctr.GetArguments()["namespace"].(string); ok {
+		ns = v
+	}
+	name, ok := ctr.GetArguments()["name"].(string)
+	if !ok || name == "" {
+		return NewTextResult("", errors.New("failed to diagnose pod, missing argument name")), nil
+	}
+	tail := int64(20)
+	if v, ok := ctr.GetArguments()["tail"].(float64); ok && v > 0 {
+		tail = int64(v)
+	}
+	derived, err := s.k.Derived(ctx)
+	if err != nil {
+		return nil, err
+	}
+	ret, err := derived.PodsDiagnose(ctx, ns, name, tail)
+	if err != nil {
+		return NewTextResult("", fmt.Errorf("failed to diagnose pod %s in namespace %s: %v", name, ns, err)), nil
+	}
+	marshalledYaml, err := output.MarshalYaml(ret)
+	if err != nil {
+		return NewTextResult("", fmt.Errorf("failed to diagnose pod: %v", err)), nil
+	}
+	return NewTextResult("# Diagnosis (YAML) of the pod "+name+"\n"+marshalledYaml, nil), nil
 }
 
 func (s *Server) podsLog(ctx context.Context, ctr mcp.CallToolRequest) (*mcp.CallToolResult, error) {
@@ -280,15 +718,15 @@ func (s *Server) podsLog(ctx context.Context, ctr mcp.CallToolRequest) (*mcp.Cal
 	if name == nil {
 		return NewTextResult("", errors.New("failed to get pod log, missing argument name")), nil
 	}
-	container := ctr.GetArguments()["container"]
-	if container == nil {
-		container = ""
+	podsLogOptions, err := parsePodsLogOptions(ctr.GetArguments())
+	if err != nil {
+		return NewTextResult("", fmt.Errorf("failed to get pod log, %v", err)), nil
 	}
 	derived, err := s.k.Derived(ctx)
 	if err != nil {
 		return nil, err
 	}
-	ret, err := derived.PodsLog(ctx, ns.(string), name.(string), container.(string))
+	ret, err := derived.PodsLog(ctx, ns.(string), name.(string), podsLogOptions)
 	if err != nil {
 		return NewTextResult("", fmt.Errorf("failed to get pod %s log in namespace %s: %v", name, ns, err)), nil
 	} else if ret == "" {
@@ -297,6 +735,154 @@ func (s *Server) podsLog(ctx context.Context, ctr mcp.CallToolRequest) (*mcp.Cal
 	return NewTextResult(ret, err), nil
 }
 
+func (s *Server) podsLogSelector(ctx context.Context, ctr mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	ns := ""
+	if v, ok := ctr.GetArguments()["namespace"].(string); ok {
+		ns = v
+	}
+	podsLogOptions, err := parsePodsLogOptions(ctr.GetArguments())
+	if err != nil {
+		return NewTextResult("", fmt.Errorf("failed to get pods log, %v", err)), nil
+	}
+	podsLogSelectorOptions := kubernetes.PodsLogSelectorOptions{PodsLogOptions: podsLogOptions}
+	if v, ok := ctr.GetArguments()["labelSelector"].(string); ok {
+		podsLogSelectorOptions.LabelSelector = v
+	}
+	if v, ok := ctr.GetArguments()["kind"].(string); ok {
+		podsLogSelectorOptions.WorkloadKind = v
+	}
+	if v, ok := ctr.GetArguments()["name"].(string); ok {
+		podsLogSelectorOptions.WorkloadName = v
+	}
+	if podsLogSelectorOptions.LabelSelector == "" && podsLogSelectorOptions.WorkloadName == "" {
+		return NewTextResult("", errors.New("failed to get pods log, missing argument labelSelector or kind and name")), nil
+	}
+	derived, err := s.k.Derived(ctx)
+	if err != nil {
+		return nil, err
+	}
+	ret, err := derived.PodsLogSelector(ctx, ns, podsLogSelectorOptions)
+	if err != nil {
+		return NewTextResult("", fmt.Errorf("failed to get pods log in namespace %s: %v", ns, err)), nil
+	} else if ret == "" {
+		ret = fmt.Sprintf("No matching pods in namespace %s have logged any message yet", ns)
+	}
+	return NewTextResult(ret, err), nil
+}
+
+// parsePodsLogOptions parses the log options shared by the pods_log and pods_log_selector tools
+func parsePodsLogOptions(arguments map[string]interface{}) (kubernetes.PodsLogOptions, error) {
+	podsLogOptions := kubernetes.PodsLogOptions{}
+	if v, ok := arguments["container"].(string); ok {
+		podsLogOptions.Container = v
+	}
+	if v, ok := arguments["previous"].(bool); ok {
+		podsLogOptions.Previous = v
+	}
+	if v, ok := arguments["tail"].(float64); ok && v > 0 {
+		podsLogOptions.TailLines = ptr.To(int64(v))
+	}
+	if v, ok := arguments["since"].(string); ok && v != "" {
+		since, err := time.ParseDuration(v)
+		if err != nil || since <= 0 {
+			return podsLogOptions, fmt.Errorf("invalid argument since: %s", v)
+		}
+		podsLogOptions.SinceSeconds = ptr.To(int64(math.Ceil(since.Seconds())))
+	}
+	if v, ok := arguments["sinceTime"].(string); ok && v != "" {
+		if podsLogOptions.SinceSeconds != nil {
+			return podsLogOptions, errors.New("since and sinceTime are mutually exclusive")
+		}
+		sinceTime, err := time.Parse(time.RFC3339, v)
+		if err != nil {
+			return podsLogOptions, fmt.Errorf("invalid argument sinceTime: %s", v)
+		}
+		podsLogOptions.SinceTime = ptr.To(metav1.NewTime(sinceTime))
+	}
+	if v, ok := arguments["timestamps"].(bool); ok {
+		podsLogOptions.Timestamps = v
+	}
+	if v, ok := arguments["limitBytes"].(float64); ok && v > 0 {
+		podsLogOptions.LimitBytes = ptr.To(int64(v))
+	}
+	return podsLogOptions, nil
+}
+
+func (s *Server) podsPortForwardStart(ctx context.Context, ctr mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	portForwardOptions := kubernetes.PortForwardOptions{}
+	if v, ok := ctr.GetArguments()["namespace"].(string); ok {
+		portForwardOptions.Namespace = v
+	}
+	if v, ok := ctr.GetArguments()["name"].(string); ok {
+		portForwardOptions.Pod = v
+	}
+	if v, ok := ctr.GetArguments()["service"].(string); ok {
+		portForwardOptions.Service = v
+	}
+	if portForwardOptions.Pod == "" && portForwardOptions.Service == "" {
+		return NewTextResult("", errors.New("failed to start port-forward, missing argument name or service")), nil
+	}
+	if portForwardOptions.Pod != "" && portForwardOptions.Service != "" {
+		return NewTextResult("", errors.New("failed to start port-forward, name and service are mutually exclusive")), nil
+	}
+	if v, ok := ctr.GetArguments()["remotePort"].(float64); ok {
+		portForwardOptions.RemotePort = int32(v)
+	}
+	if v, ok := ctr.GetArguments()["localPort"].(float64); ok {
+		portForwardOptions.LocalPort = int32(v)
+	}
+	derived, err := s.k.Derived(ctx)
+	if err != nil {
+		return nil, err
+	}
+	pf, err := derived.PortForwardStart(ctx, portForwardOptions)
+	if err != nil {
+		return NewTextResult("", fmt.Errorf("failed to start port-forward in namespace %s: %v", portForwardOptions.Namespace, err)), nil
+	}
+	s.portForwards.Add(portForwardOwner(ctx), pf)
+	marshalledYaml, err := output.MarshalYaml(pf)
+	if err != nil {
+		err = fmt.Errorf("failed to start port-forward: %v", err)
+	}
+	return NewTextResult("# The following port-forward session (YAML) has been started successfully\n"+marshalledYaml, err), nil
+}
+
+func (s *Server) podsPortForwardList(ctx context.Context, _ mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	sessions := s.portForwards.List(portForwardOwner(ctx))
+	if len(sessions) == 0 {
+		return NewTextResult("No active port-forward sessions", nil), nil
+	}
+	marshalledYaml, err := output.MarshalYaml(sessions)
+	if err != nil {
+		err = fmt.Errorf("failed to list port-forward sessions: %v", err)
+	}
+	return NewTextResult("# The following port-forward sessions (YAML) are active\n"+marshalledYaml, err), nil
+}
+
+func (s *Server) podsPortForwardStop(ctx context.Context, ctr mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	id, ok := ctr.GetArguments()["id"].(string)
+	if !ok || id == "" {
+		return NewTextResult("", errors.New("failed to stop port-forward, missing argument id")), nil
+	}
+	if err := s.portForwards.Stop(portForwardOwner(ctx), id); err != nil {
+		return NewTextResult("", fmt.Errorf("failed to stop port-forward: %v", err)), nil
+	}
+	return NewTextResult(fmt.Sprintf("Port-forward session %s stopped successfully", id), nil), nil
+}
+
+// portForwardOwner identifies the owner of the port-forward sessions: the MCP session,
+// or the user (Authorization header) for stateless requests (Streamable HTTP) that have no session id
+func portForwardOwner(ctx context.Context) string {
+	if session := server.ClientSessionFromContext(ctx); session != nil && session.SessionID() != "" {
+		return session.SessionID()
+	}
+	if authHeader, ok := ctx.Value(kubernetes.OAuthAuthorizationHeader).(string); ok && authHeader != "" {
+		hash := sha256.Sum256([]byte(authHeader))
+		return "user-" + hex.EncodeToString(hash[:])
+	}
+	return ""
+}
+
 func (s *Server) podsRun(ctx context.Context, ctr mcp.CallToolRequest) (*mcp.CallToolResult, error) {
 	ns := ctr.GetArguments()["namespace"]
 	if ns == nil {
@@ -314,11 +900,43 @@ func (s *Server) podsRun(ctx context.Context, ctr mcp.CallToolRequest) (*mcp.Cal
 	if port == nil {
 		port = float64(0)
 	}
+	podsRunOptions := kubernetes.PodsRunOptions{
+		Name:    name.(string),
+		Image:   image.(string),
+		Port:    int32(port.(float64)),
+		Command: stringArray(ctr.GetArguments()["command"]),
+		Args:    stringArray(ctr.GetArguments()["args"]),
+		Env:     stringMap(ctr.GetArguments()["env"]),
+		Labels:  stringMap(ctr.GetArguments()["labels"]),
+	}
+	var err error
+	if podsRunOptions.Resources.Requests, err = resourceList(ctr.GetArguments()["requests"]); err != nil {
+		return NewTextResult("", fmt.Errorf("failed to run pod, invalid argument requests: %v", err)), nil
+	}
+	if podsRunOptions.Resources.Limits, err = resourceList(ctr.GetArguments()["limits"]); err != nil {
+		return NewTextResult("", fmt.Errorf("failed to run pod, invalid argument limits: %v", err)), nil
+	}
+	if v, ok := ctr.GetArguments()["imagePullPolicy"].(string); ok {
+		podsRunOptions.ImagePullPolicy = corev1.PullPolicy(v)
+	}
+	if v, ok := ctr.GetArguments()["restartPolicy"].(string); ok {
+		podsRunOptions.RestartPolicy = corev1.RestartPolicy(v)
+	}
+	if v, ok := ctr.GetArguments()["serviceAccount"].(string); ok {
+		podsRunOptions.ServiceAccount = v
+	}
+	waitForReady, _ := ctr.GetArguments()["wait"].(bool)
+	waitTimeout := 2 * time.Minute
+	if v, ok := ctr.GetArguments()["waitTimeout"].(string); ok && v != "" {
+		if waitTimeout, err = time.ParseDuration(v); err != nil || waitTimeout <= 0 {
+			return NewTextResult("", fmt.Errorf("failed to run pod, invalid argument waitTimeout: %s", v)), nil
+		}
+	}
 	derived, err := s.k.Derived(ctx)
 	if err != nil {
 		return nil, err
 	}
-	resources, err := derived.PodsRun(ctx, ns.(string), name.(string), image.(string), int32(port.(float64)))
+	resources, err := derived.PodsRun(ctx, ns.(string), podsRunOptions)
 	if err != nil {
 		return NewTextResult("", fmt.Errorf("failed to run pod %s in namespace %s: %v", name, ns, err)), nil
 	}
@@ -326,5 +944,65 @@ func (s *Server) podsRun(ctx context.Context, ctr mcp.CallToolRequest) (*mcp.Cal
 	if err != nil {
 		err = fmt.Errorf("failed to run pod: %v", err)
 	}
-	return NewTextResult("# The following resources (YAML) have been created or updated successfully\n"+marshalledYaml, err), nil
+	ret := "# The following resources (YAML) have been created or updated successfully\n" + marshalledYaml
+	if waitForReady && err == nil {
+		status, waitErr := derived.PodsWaitForReady(ctx, resources[0].GetNamespace(), resources[0].GetName(), waitTimeout)
+		if waitErr != nil {
+			return NewTextResult("", fmt.Errorf("failed to wait for pod %s in namespace %s: %v", resources[0].GetName(), resources[0].GetNamespace(), waitErr)), nil
+		}
+		statusYaml, _ := output.MarshalYaml(status)
+		ret += "---\n# Pod status (YAML) after waiting for the Pod to be ready\n" + statusYaml
+	}
+	return NewTextResult(ret, err), nil
+}
+
+// stringArray converts an array argument to a string slice ignoring any non-string items
+func stringArray(arg interface{}) []string {
+	var ret []string
+	if items, ok := arg.([]interface{}); ok {
+		for _, item := range items {
+			if str, ok := item.(string); ok {
+				ret = append(ret, str)
+			}
+		}
+	}
+	return ret
+}
+
+// stringMap converts an object argument to a string map, non-string values are formatted with their default format
+func stringMap(arg interface{}) map[string]string {
+	obj, ok := arg.(map[string]interface{})
+	if !ok {
+		return nil
+	}
+	ret := make(map[string]string, len(obj))
+	for k, v := range obj {
+		switch value := v.(type) {
+		case string:
+			ret[k] = value
+		// JSON numbers are decoded as float64, avoid the exponent notation of %v (e.g. 1e+06)
+		case float64:
+			ret[k] = strconv.FormatFloat(value, 'f', -1, 64)
+		default:
+			ret[k] = fmt.Sprintf("%v", v)
+		}
+	}
+	return ret
+}
+
+// resourceList converts an object argument (e.g. {"cpu": "100m", "memory": "128Mi"}) to a ResourceList
+func resourceList(arg interface{}) (corev1.ResourceList, error) {
+	quantities := stringMap(arg)
+	if quantities == nil {
+		return nil, nil
+	}
+	ret := make(corev1.ResourceList, len(quantities))
+	for k, v := range quantities {
+		quantity, err := resource.ParseQuantity(v)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %v", k, err)
+		}
+		ret[corev1.ResourceName(k)] = quantity
+	}
+	return ret, nil
 }
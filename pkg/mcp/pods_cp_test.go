@@ -0,0 +1,165 @@
+package mcp
+
+import (
+	"archive/tar"
+	"bytes"
+	"io"
+	"net/http"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/yaml"
+
+	"github.com/manusa/kubernetes-mcp-server/pkg/config"
+	"github.com/manusa/kubernetes-mcp-server/pkg/kubernetes"
+)
+
+func TestPodsCp(t *testing.T) {
+	testCase(t, func(c *mcpContext) {
+		mockServer := NewMockServer()
+		defer mockServer.Close()
+		c.withKubeConfig(mockServer.config)
+		var received sync.Map
+		mockServer.Handle(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+			if req.URL.Path != "/api/v1/namespaces/default/pods/pod-to-cp/exec" {
+				return
+			}
+			streamOptions := &StreamOptions{Stdout: &bytes.Buffer{}, Stderr: &bytes.Buffer{}}
+			if req.URL.Query().Get("stdin") == "true" {
+				streamOptions.Stdin = &bytes.Buffer{}
+			}
+			ctx, err := createHTTPStreams(w, req, streamOptions)
+			if err != nil {
+				w.WriteHeader(http.StatusInternalServerError)
+				_, _ = w.Write([]byte(err.Error()))
+				return
+			}
+			defer func(conn io.Closer) { _ = conn.Close() }(ctx.conn)
+			command := strings.Join(req.URL.Query()["command"], " ")
+			switch command {
+			case "tar cf - -C /var/log/ -- huge":
+				_, _ = ctx.stdoutStream.Write(make([]byte, kubernetes.PodsCpMaxArchiveBytes+1))
+			case "tar cf - -C /etc/ -- app":
+				tarWriter := tar.NewWriter(ctx.stdoutStream)
+				_ = tarWriter.WriteHeader(&tar.Header{Typeflag: tar.TypeDir, Name: "app/", Mode: 0755})
+				_ = tarWriter.WriteHeader(&tar.Header{Typeflag: tar.TypeReg, Name: "app/config.yaml", Mode: 0644, Size: 10})
+				_, _ = tarWriter.Write([]byte("key: value"))
+				_ = tarWriter.WriteHeader(&tar.Header{Typeflag: tar.TypeReg, Name: "app/data.bin", Mode: 0644, Size: 3})
+				_, _ = tarWriter.Write([]byte{0, 1, 2})
+				_ = tarWriter.Close()
+			case "tar xf - -C /":
+				tarReader := tar.NewReader(ctx.stdinStream)
+				for {
+					header, tarErr := tarReader.Next()
+					if tarErr != nil {
+						break
+					}
+					content, _ := io.ReadAll(tarReader)
+					received.Store(header.Name, content)
+				}
+			}
+		}))
+		mockServer.Handle(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+			if req.URL.Path != "/api/v1/namespaces/default/pods/pod-to-cp" {
+				return
+			}
+			writeObject(w, &v1.Pod{
+				ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "pod-to-cp"},
+				Spec:       v1.PodSpec{Containers: []v1.Container{{Name: "container"}}},
+			})
+		}))
+		t.Run("pods_cp_from with missing path returns error", func(t *testing.T) {
+			toolResult, _ := c.callTool("pods_cp_from", map[string]interface{}{"name": "pod-to-cp"})
+			if !toolResult.IsError {
+				t.Fatalf("call tool should fail")
+			}
+			if toolResult.Content[0].(mcp.TextContent).Text != "failed to copy from pod, missing argument path" {
+				t.Fatalf("invalid error message, got %v", toolResult.Content[0].(mcp.TextContent).Text)
+			}
+		})
+		t.Run("pods_cp_from with directory returns text and base64 files", func(t *testing.T) {
+			toolResult, err := c.callTool("pods_cp_from", map[string]interface{}{"name": "pod-to-cp", "path": "/etc/app"})
+			if err != nil || toolResult.IsError {
+				t.Fatalf("call tool failed %v %v", err, toolResult.Content)
+			}
+			var decoded []map[string]interface{}
+			if err = yaml.Unmarshal([]byte(toolResult.Content[0].(mcp.TextContent).Text), &decoded); err != nil {
+				t.Fatalf("invalid tool result content %v", err)
+			}
+			if len(decoded) != 2 {
+				t.Fatalf("invalid files count, expected 2, got %v", len(decoded))
+			}
+			if decoded[0]["path"] != "/etc/app/config.yaml" || decoded[0]["encoding"] != "text" || decoded[0]["content"] != "key: value" {
+				t.Errorf("unexpected text file %v", decoded[0])
+			}
+			if decoded[1]["path"] != "/etc/app/data.bin" || decoded[1]["encoding"] != "base64" || decoded[1]["content"] != "AAEC" {
+				t.Errorf("unexpected binary file %v", decoded[1])
+			}
+		})
+		t.Run("pods_cp_from with archive exceeding the maximum size returns error", func(t *testing.T) {
+			toolResult, _ := c.callTool("pods_cp_from", map[string]interface{}{"name": "pod-to-cp", "path": "/var/log/huge"})
+			if !toolResult.IsError {
+				t.Fatalf("call tool should fail")
+			}
+			if !strings.HasSuffix(toolResult.Content[0].(mcp.TextContent).Text, "command output exceeds the maximum size of 10485760 bytes") {
+				t.Fatalf("invalid error message, got %v", toolResult.Content[0].(mcp.TextContent).Text)
+			}
+		})
+		t.Run("pods_cp_to with relative path returns error", func(t *testing.T) {
+			toolResult, _ := c.callTool("pods_cp_to", map[string]interface{}{
+				"name":  "pod-to-cp",
+				"files": []interface{}{map[string]interface{}{"path": "tmp/file", "content": "text"}},
+			})
+			if !toolResult.IsError {
+				t.Fatalf("call tool should fail")
+			}
+			if !strings.HasSuffix(toolResult.Content[0].(mcp.TextContent).Text, "file path must be absolute: tmp/file") {
+				t.Fatalf("invalid error message, got %v", toolResult.Content[0].(mcp.TextContent).Text)
+			}
+		})
+		t.Run("pods_cp_to writes text and base64 files", func(t *testing.T) {
+			toolResult, err := c.callTool("pods_cp_to", map[string]interface{}{
+				"name": "pod-to-cp",
+				"files": []interface{}{
+					map[string]interface{}{"path": "/tmp/config.yaml", "content": "key: value"},
+					map[string]interface{}{"path": "/tmp/data.bin", "content": "AAEC", "encoding": "base64"},
+				},
+			})
+			if err != nil || toolResult.IsError {
+				t.Fatalf("call tool failed %v %v", err, toolResult.Content)
+			}
+			if toolResult.Content[0].(mcp.TextContent).Text != "Files copied successfully to pod pod-to-cp in namespace : /tmp/config.yaml, /tmp/data.bin" {
+				t.Errorf("unexpected result %v", toolResult.Content[0].(mcp.TextContent).Text)
+			}
+			if content, _ := received.Load("tmp/config.yaml"); !bytes.Equal(content.([]byte), []byte("key: value")) {
+				t.Errorf("unexpected text file content %v", content)
+			}
+			if content, _ := received.Load("tmp/data.bin"); !bytes.Equal(content.([]byte), []byte{0, 1, 2}) {
+				t.Errorf("unexpected binary file content %v", content)
+			}
+		})
+	})
+}
+
+func TestPodsCpDenied(t *testing.T) {
+	deniedResourcesServer := &config.StaticConfig{DeniedResources: []config.GroupVersionKind{{Version: "v1", Kind: "Pod"}}}
+	testCaseWithContext(t, &mcpContext{staticConfig: deniedResourcesServer}, func(c *mcpContext) {
+		c.withEnvTest()
+		podsCpFrom, _ := c.callTool("pods_cp_from", map[string]interface{}{"name": "a-pod-in-default", "path": "/etc/hosts"})
+		t.Run("pods_cp_from has error", func(t *testing.T) {
+			if !podsCpFrom.IsError {
+				t.Fatalf("call tool should fail")
+			}
+		})
+		t.Run("pods_cp_from describes denial", func(t *testing.T) {
+			expectedMessage := "failed to copy /etc/hosts from pod a-pod-in-default in namespace : resource not allowed: /v1, Kind=Pod"
+			if podsCpFrom.Content[0].(mcp.TextContent).Text != expectedMessage {
+				t.Fatalf("expected descriptive error '%s', got %v", expectedMessage, podsCpFrom.Content[0].(mcp.TextContent).Text)
+			}
+		})
+	})
+}
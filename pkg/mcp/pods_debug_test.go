@@ -0,0 +1,169 @@
+package mcp
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/watch"
+	"k8s.io/client-go/kubernetes/scheme"
+
+	"github.com/manusa/kubernetes-mcp-server/pkg/config"
+)
+
+func TestPodsDebug(t *testing.T) {
+	testCase(t, func(c *mcpContext) {
+		mockServer := NewMockServer()
+		defer mockServer.Close()
+		c.withKubeConfig(mockServer.config)
+		var mu sync.Mutex
+		pod := &v1.Pod{
+			TypeMeta:   metav1.TypeMeta{APIVersion: "v1", Kind: "Pod"},
+			ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "pod-to-debug"},
+			Spec:       v1.PodSpec{Containers: []v1.Container{{Name: "distroless"}}},
+			Status:     v1.PodStatus{Phase: v1.PodRunning},
+		}
+		mockServer.Handle(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+			mu.Lock()
+			defer mu.Unlock()
+			switch {
+			case req.URL.Path == "/api/v1/namespaces/default/pods/pod-to-debug" && req.Method == http.MethodGet:
+				writeObject(w, pod)
+			case req.URL.Path == "/api/v1/namespaces/default/pods/pod-to-debug/ephemeralcontainers" && req.Method == http.MethodPut:
+				updated := &v1.Pod{}
+				body, _ := io.ReadAll(req.Body)
+				_, _, _ = scheme.Codecs.UniversalDeserializer().Decode(body, nil, updated)
+				pod.Spec.EphemeralContainers = updated.Spec.EphemeralContainers
+				for _, ec := range updated.Spec.EphemeralContainers {
+					pod.Status.EphemeralContainerStatuses = append(pod.Status.EphemeralContainerStatuses, v1.ContainerStatus{
+						Name: ec.Name, Image: ec.Image, State: v1.ContainerState{Running: &v1.ContainerStateRunning{}},
+					})
+				}
+				writeObject(w, pod)
+			case req.URL.Path == "/api/v1/namespaces/default/pods" && req.URL.Query().Get("watch") == "true":
+				w.Header().Set("Content-Type", runtime.ContentTypeJSON)
+				raw, _ := json.Marshal(pod)
+				_ = json.NewEncoder(w).Encode(&metav1.WatchEvent{Type: string(watch.Modified), Object: runtime.RawExtension{Raw: raw}})
+			}
+		}))
+		mockServer.Handle(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+			if req.URL.Path != "/api/v1/namespaces/default/pods/pod-to-debug/exec" {
+				return
+			}
+			if req.URL.Query().Get("command") == "unreachable" {
+				w.WriteHeader(http.StatusInternalServerError)
+				_, _ = w.Write([]byte("exec not available"))
+				return
+			}
+			ctx, err := createHTTPStreams(w, req, &StreamOptions{Stdout: &strings.Builder{}, Stderr: &strings.Builder{}})
+			if err != nil {
+				w.WriteHeader(http.StatusInternalServerError)
+				_, _ = w.Write([]byte(err.Error()))
+				return
+			}
+			defer func(conn io.Closer) { _ = conn.Close() }(ctx.conn)
+			_, _ = io.WriteString(ctx.stdoutStream, "container:"+req.URL.Query().Get("container")+"\n")
+			_, _ = io.WriteString(ctx.stdoutStream, "command:"+strings.Join(req.URL.Query()["command"], " ")+"\n")
+		}))
+		t.Run("pods_debug with missing image returns error", func(t *testing.T) {
+			toolResult, _ := c.callTool("pods_debug", map[string]interface{}{"name": "pod-to-debug"})
+			if !toolResult.IsError {
+				t.Fatalf("call tool should fail")
+			}
+			if toolResult.Content[0].(mcp.TextContent).Text != "failed to debug pod, missing argument image" {
+				t.Fatalf("invalid error message, got %v", toolResult.Content[0].(mcp.TextContent).Text)
+			}
+		})
+		t.Run("pods_debug with unknown target returns error", func(t *testing.T) {
+			toolResult, _ := c.callTool("pods_debug", map[string]interface{}{"name": "pod-to-debug", "image": "busybox", "target": "unknown"})
+			if !toolResult.IsError {
+				t.Fatalf("call tool should fail")
+			}
+			if !strings.HasSuffix(toolResult.Content[0].(mcp.TextContent).Text, "container unknown not found in pod pod-to-debug") {
+				t.Fatalf("invalid error message, got %v", toolResult.Content[0].(mcp.TextContent).Text)
+			}
+		})
+		toolResult, err := c.callTool("pods_debug", map[string]interface{}{
+			"name":      "pod-to-debug",
+			"image":     "busybox",
+			"container": "debugger",
+			"target":    "distroless",
+			"command":   []interface{}{"ps", "aux"},
+		})
+		t.Run("pods_debug adds ephemeral container", func(t *testing.T) {
+			if err != nil || toolResult.IsError {
+				t.Fatalf("call tool failed %v %v", err, toolResult.Content)
+			}
+			mu.Lock()
+			defer mu.Unlock()
+			if len(pod.Spec.EphemeralContainers) != 1 {
+				t.Fatalf("expected 1 ephemeral container, got %v", len(pod.Spec.EphemeralContainers))
+			}
+			ec := pod.Spec.EphemeralContainers[0]
+			if ec.Name != "debugger" || ec.Image != "busybox" || ec.TargetContainerName != "distroless" {
+				t.Errorf("unexpected ephemeral container %v", ec)
+			}
+		})
+		t.Run("pods_debug reports ephemeral container state", func(t *testing.T) {
+			if !strings.Contains(toolResult.Content[0].(mcp.TextContent).Text, "state: Running") {
+				t.Errorf("unexpected result %v", toolResult.Content[0].(mcp.TextContent).Text)
+			}
+		})
+		t.Run("pods_debug executes command in ephemeral container", func(t *testing.T) {
+			if !strings.Contains(toolResult.Content[0].(mcp.TextContent).Text, "container:debugger\ncommand:ps aux\n") {
+				t.Errorf("unexpected result %v", toolResult.Content[0].(mcp.TextContent).Text)
+			}
+		})
+		t.Run("pods_debug with failing command returns error and reports ephemeral container", func(t *testing.T) {
+			failed, _ := c.callTool("pods_debug", map[string]interface{}{
+				"name": "pod-to-debug", "image": "busybox", "container": "debugger-failed", "command": []interface{}{"unreachable"},
+			})
+			if !failed.IsError {
+				t.Fatalf("call tool should fail")
+			}
+			text := failed.Content[0].(mcp.TextContent).Text
+			if !strings.HasPrefix(text, "failed to debug pod pod-to-debug in namespace : ") {
+				t.Errorf("invalid error message, got %v", text)
+			}
+			if !strings.Contains(text, "# The following ephemeral container (YAML) has been added to pod pod-to-debug in namespace \n") ||
+				!strings.Contains(text, "name: debugger-failed\n") || !strings.Contains(text, "state: Running\n") {
+				t.Errorf("expected ephemeral container to be reported, got %v", text)
+			}
+		})
+		t.Run("pods_debug with existing container name returns error", func(t *testing.T) {
+			existing, _ := c.callTool("pods_debug", map[string]interface{}{"name": "pod-to-debug", "image": "busybox", "container": "debugger"})
+			if !existing.IsError {
+				t.Fatalf("call tool should fail")
+			}
+			if !strings.HasSuffix(existing.Content[0].(mcp.TextContent).Text, "ephemeral container debugger already exists in pod pod-to-debug") {
+				t.Fatalf("invalid error message, got %v", existing.Content[0].(mcp.TextContent).Text)
+			}
+		})
+	})
+}
+
+func TestPodsDebugDenied(t *testing.T) {
+	deniedResourcesServer := &config.StaticConfig{DeniedResources: []config.GroupVersionKind{{Version: "v1", Kind: "Pod"}}}
+	testCaseWithContext(t, &mcpContext{staticConfig: deniedResourcesServer}, func(c *mcpContext) {
+		c.withEnvTest()
+		podsDebug, _ := c.callTool("pods_debug", map[string]interface{}{"name": "a-pod-in-default", "image": "busybox"})
+		t.Run("pods_debug has error", func(t *testing.T) {
+			if !podsDebug.IsError {
+				t.Fatalf("call tool should fail")
+			}
+		})
+		t.Run("pods_debug describes denial", func(t *testing.T) {
+			expectedMessage := "failed to debug pod a-pod-in-default in namespace : resource not allowed: /v1, Kind=Pod"
+			if podsDebug.Content[0].(mcp.TextContent).Text != expectedMessage {
+				t.Fatalf("expected descriptive error '%s', got %v", expectedMessage, podsDebug.Content[0].(mcp.TextContent).Text)
+			}
+		})
+	})
+}
@@ -0,0 +1,148 @@
+package mcp
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/yaml"
+
+	"github.com/manusa/kubernetes-mcp-server/pkg/config"
+)
+
+func TestPodsDiagnose(t *testing.T) {
+	testCase(t, func(c *mcpContext) {
+		mockServer := NewMockServer()
+		defer mockServer.Close()
+		c.withKubeConfig(mockServer.config)
+		mockServer.Handle(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+			w.Header().Set("Content-Type", "application/json")
+			switch req.URL.Path {
+			// Request Performed by DiscoveryClient to Kube API (Get API Groups legacy -core-)
+			case "/api":
+				_, _ = w.Write([]byte(`{"kind":"APIVersions","versions":["v1"],"serverAddressByClientCIDRs":[{"clientCIDR":"0.0.0.0/0"}]}`))
+			// Request Performed by DiscoveryClient to Kube API (Get API Groups)
+			case "/apis":
+				_, _ = w.Write([]byte(`{"kind":"APIGroupList","apiVersion":"v1","groups":[]}`))
+			// Request Performed by DiscoveryClient to Kube API (Get API Resources)
+			case "/api/v1":
+				_, _ = w.Write([]byte(`{"kind":"APIResourceList","apiVersion":"v1","groupVersion":"v1","resources":[` +
+					`{"name":"pods","singularName":"","namespaced":true,"kind":"Pod","verbs":["get","list"]},` +
+					`{"name":"events","singularName":"","namespaced":true,"kind":"Event","verbs":["get","list"]},` +
+					`{"name":"nodes","singularName":"","namespaced":false,"kind":"Node","verbs":["get","list"]}` +
+					`]}`))
+			case "/api/v1/namespaces/default/events":
+				// Events are filtered server-side
+				if req.URL.Query().Get("fieldSelector") != "involvedObject.kind=Pod,involvedObject.name=crashing-pod" {
+					_, _ = w.Write([]byte(`{"kind":"EventList","apiVersion":"v1","items":[]}`))
+					return
+				}
+				_, _ = w.Write([]byte(`{"kind":"EventList","apiVersion":"v1","items":[` +
+					`{"metadata":{"name":"e1","namespace":"default"},"involvedObject":{"kind":"Pod","name":"crashing-pod"},"type":"Warning","reason":"BackOff","message":"Back-off restarting failed container"},` +
+					`{"metadata":{"name":"e2","namespace":"default"},"involvedObject":{"kind":"Pod","name":"crashing-pod"},"type":"Normal","reason":"Pulled","message":"Container image pulled"}` +
+					`]}`))
+			case "/api/v1/nodes/node-1":
+				writeObject(w, &v1.Node{
+					TypeMeta:   metav1.TypeMeta{APIVersion: "v1", Kind: "Node"},
+					ObjectMeta: metav1.ObjectMeta{Name: "node-1"},
+					Status: v1.NodeStatus{Conditions: []v1.NodeCondition{
+						{Type: v1.NodeReady, Status: v1.ConditionTrue},
+						{Type: v1.NodeMemoryPressure, Status: v1.ConditionTrue, Message: "kubelet has insufficient memory available"},
+					}},
+				})
+			case "/api/v1/namespaces/default/pods/crashing-pod/log":
+				if req.URL.Query().Get("previous") == "true" && req.URL.Query().Get("tailLines") == "20" {
+					_, _ = w.Write([]byte("fatal error: out of memory\n"))
+				}
+			case "/api/v1/namespaces/default/pods/crashing-pod":
+				writeObject(w, &v1.Pod{
+					TypeMeta:   metav1.TypeMeta{APIVersion: "v1", Kind: "Pod"},
+					ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "crashing-pod"},
+					Spec:       v1.PodSpec{NodeName: "node-1", Containers: []v1.Container{{Name: "app"}}},
+					Status: v1.PodStatus{
+						Phase:      v1.PodRunning,
+						Conditions: []v1.PodCondition{{Type: v1.PodReady, Status: v1.ConditionFalse, Reason: "ContainersNotReady"}},
+						ContainerStatuses: []v1.ContainerStatus{{
+							Name:         "app",
+							Image:        "app:latest",
+							RestartCount: 5,
+							State:        v1.ContainerState{Waiting: &v1.ContainerStateWaiting{Reason: "CrashLoopBackOff", Message: "back-off 5m0s"}},
+							LastTerminationState: v1.ContainerState{Terminated: &v1.ContainerStateTerminated{
+								Reason: "OOMKilled", ExitCode: 137,
+							}},
+						}},
+					},
+				})
+			}
+		}))
+		t.Run("pods_diagnose with missing name returns error", func(t *testing.T) {
+			toolResult, _ := c.callTool("pods_diagnose", map[string]interface{}{})
+			if !toolResult.IsError {
+				t.Fatalf("call tool should fail")
+			}
+			if toolResult.Content[0].(mcp.TextContent).Text != "failed to diagnose pod, missing argument name" {
+				t.Fatalf("invalid error message, got %v", toolResult.Content[0].(mcp.TextContent).Text)
+			}
+		})
+		toolResult, err := c.callTool("pods_diagnose", map[string]interface{}{"name": "crashing-pod"})
+		var diagnosis map[string]interface{}
+		t.Run("pods_diagnose returns diagnosis", func(t *testing.T) {
+			if err != nil || toolResult.IsError {
+				t.Fatalf("call tool failed %v %v", err, toolResult.Content)
+			}
+			if err = yaml.Unmarshal([]byte(toolResult.Content[0].(mcp.TextContent).Text), &diagnosis); err != nil {
+				t.Fatalf("invalid tool result content %v", err)
+			}
+			if _, ok := diagnosis["errors"]; ok {
+				t.Errorf("unexpected errors %v", diagnosis["errors"])
+			}
+		})
+		t.Run("pods_diagnose returns findings", func(t *testing.T) {
+			findings := fmt.Sprintf("%v", diagnosis["findings"])
+			for _, expected := range []string{
+				"container app is waiting: CrashLoopBackOff: back-off 5m0s",
+				"container app has restarted 5 times, last terminated with reason OOMKilled (exit code 137)",
+				"node node-1 has condition MemoryPressure=True: kubelet has insufficient memory available",
+			} {
+				if !strings.Contains(findings, expected) {
+					t.Errorf("expected finding %s, got %v", expected, findings)
+				}
+			}
+		})
+		t.Run("pods_diagnose returns previous logs of restarting containers", func(t *testing.T) {
+			container := diagnosis["containers"].([]interface{})[0].(map[string]interface{})
+			if container["previousLogs"] != "fatal error: out of memory\n" {
+				t.Errorf("unexpected previous logs %v", container["previousLogs"])
+			}
+		})
+		t.Run("pods_diagnose returns only warning events of the pod", func(t *testing.T) {
+			events := diagnosis["events"].([]interface{})
+			if len(events) != 1 || events[0].(map[string]interface{})["Reason"] != "BackOff" {
+				t.Errorf("unexpected events %v", events)
+			}
+		})
+	})
+}
+
+func TestPodsDiagnoseDenied(t *testing.T) {
+	deniedResourcesServer := &config.StaticConfig{DeniedResources: []config.GroupVersionKind{{Version: "v1", Kind: "Pod"}}}
+	testCaseWithContext(t, &mcpContext{staticConfig: deniedResourcesServer}, func(c *mcpContext) {
+		c.withEnvTest()
+		podsDiagnose, _ := c.callTool("pods_diagnose", map[string]interface{}{"name": "a-pod-in-default"})
+		t.Run("pods_diagnose has error", func(t *testing.T) {
+			if !podsDiagnose.IsError {
+				t.Fatalf("call tool should fail")
+			}
+		})
+		t.Run("pods_diagnose describes denial", func(t *testing.T) {
+			expectedMessage := "failed to diagnose pod a-pod-in-default in namespace : resource not allowed: /v1, Kind=Pod"
+			if podsDiagnose.Content[0].(mcp.TextContent).Text != expectedMessage {
+				t.Fatalf("expected descriptive error '%s', got %v", expectedMessage, podsDiagnose.Content[0].(mcp.TextContent).Text)
+			}
+		})
+	})
+}
@@ -0,0 +1,130 @@
+package mcp
+
+import (
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/manusa/kubernetes-mcp-server/pkg/config"
+)
+
+func TestPodsEvict(t *testing.T) {
+	testCase(t, func(c *mcpContext) {
+		mockServer := NewMockServer()
+		defer mockServer.Close()
+		c.withKubeConfig(mockServer.config)
+		var evicted []string
+		mockServer.Handle(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+			w.Header().Set("Content-Type", "application/json")
+			switch req.URL.Path {
+			// Request Performed by DiscoveryClient to Kube API (Get API Groups legacy -core-)
+			case "/api":
+				_, _ = w.Write([]byte(`{"kind":"APIVersions","versions":["v1"],"serverAddressByClientCIDRs":[{"clientCIDR":"0.0.0.0/0"}]}`))
+			// Request Performed by DiscoveryClient to Kube API (Get API Groups)
+			case "/apis":
+				_, _ = w.Write([]byte(`{"kind":"APIGroupList","apiVersion":"v1","groups":[` +
+					`{"name":"policy","versions":[{"groupVersion":"policy/v1","version":"v1"}],"preferredVersion":{"groupVersion":"policy/v1","version":"v1"}}` +
+					`]}`))
+			// Request Performed by DiscoveryClient to Kube API (Get API Resources)
+			case "/apis/policy/v1":
+				_, _ = w.Write([]byte(`{"kind":"APIResourceList","apiVersion":"v1","groupVersion":"policy/v1","resources":[{"name":"poddisruptionbudgets","singularName":"","namespaced":true,"kind":"PodDisruptionBudget","verbs":["get","list"]}]}`))
+			case "/apis/policy/v1/namespaces/default/poddisruptionbudgets":
+				_, _ = w.Write([]byte(`{"kind":"PodDisruptionBudgetList","apiVersion":"policy/v1","items":[` +
+					`{"metadata":{"name":"invalid-pdb","namespace":"default"},"status":{"disruptionsAllowed":"none"}},` +
+					`{"metadata":{"name":"protected-pdb","namespace":"default"},"spec":{"minAvailable":1,"selector":{"matchLabels":{"app":"protected"}}},` +
+					`"status":{"disruptionsAllowed":0,"currentHealthy":1,"desiredHealthy":1,"expectedPods":1}},` +
+					`{"metadata":{"name":"unrelated-pdb","namespace":"default"},"spec":{"minAvailable":1,"selector":{"matchLabels":{"app":"unrelated"}}},` +
+					`"status":{"disruptionsAllowed":0,"currentHealthy":1,"desiredHealthy":1,"expectedPods":1}},` +
+					`{"metadata":{"name":"namespace-pdb","namespace":"default"},"spec":{"maxUnavailable":0,"selector":{}},` +
+					`"status":{"disruptionsAllowed":0,"currentHealthy":2,"desiredHealthy":2,"expectedPods":2}}` +
+					`]}`))
+			case "/api/v1/namespaces/default/pods/evictable-pod", "/api/v1/namespaces/default/pods/protected-pod":
+				name := req.URL.Path[strings.LastIndex(req.URL.Path, "/")+1:]
+				writeObject(w, &v1.Pod{
+					TypeMeta:   metav1.TypeMeta{APIVersion: "v1", Kind: "Pod"},
+					ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: name, Labels: map[string]string{"app": strings.TrimSuffix(name, "-pod")}},
+				})
+			case "/api/v1/namespaces/default/pods/evictable-pod/eviction":
+				evicted = append(evicted, "evictable-pod")
+				w.WriteHeader(http.StatusCreated)
+				_, _ = w.Write([]byte(`{"kind":"Status","apiVersion":"v1","status":"Success","code":201}`))
+			case "/api/v1/namespaces/default/pods/protected-pod/eviction":
+				w.WriteHeader(http.StatusTooManyRequests)
+				_, _ = w.Write([]byte(`{"kind":"Status","apiVersion":"v1","status":"Failure","code":429,"reason":"TooManyRequests",` +
+					`"message":"Cannot evict pod as it would violate the pod's disruption budget.",` +
+					`"details":{"causes":[{"reason":"DisruptionBudget","message":"The disruption budget protected-pdb needs 1 healthy pods and has 1 currently"}]}}`))
+			}
+		}))
+		t.Run("pods_evict with missing name returns error", func(t *testing.T) {
+			toolResult, _ := c.callTool("pods_evict", map[string]interface{}{})
+			if !toolResult.IsError {
+				t.Fatalf("call tool should fail")
+			}
+			if toolResult.Content[0].(mcp.TextContent).Text != "failed to evict pod, missing argument name" {
+				t.Fatalf("invalid error message, got %v", toolResult.Content[0].(mcp.TextContent).Text)
+			}
+		})
+		t.Run("pods_evict with evictable pod evicts pod", func(t *testing.T) {
+			toolResult, err := c.callTool("pods_evict", map[string]interface{}{"name": "evictable-pod"})
+			if err != nil || toolResult.IsError {
+				t.Fatalf("call tool failed %v %v", err, toolResult.Content)
+			}
+			if toolResult.Content[0].(mcp.TextContent).Text != "Pod evicted successfully" {
+				t.Errorf("unexpected result %v", toolResult.Content[0].(mcp.TextContent).Text)
+			}
+			if len(evicted) != 1 {
+				t.Errorf("expected eviction request, got %v", evicted)
+			}
+		})
+		toolResult, _ := c.callTool("pods_evict", map[string]interface{}{"name": "protected-pod"})
+		t.Run("pods_evict with pod protected by PDB returns error", func(t *testing.T) {
+			if !toolResult.IsError {
+				t.Fatalf("call tool should fail")
+			}
+			if !strings.HasPrefix(toolResult.Content[0].(mcp.TextContent).Text, "failed to evict pod protected-pod in namespace : eviction blocked: ") {
+				t.Errorf("invalid error message, got %v", toolResult.Content[0].(mcp.TextContent).Text)
+			}
+		})
+		t.Run("pods_evict with pod protected by PDB describes blocking PDB", func(t *testing.T) {
+			expected := "PodDisruptionBudget protected-pdb allows 0 disruptions (current healthy: 1, desired healthy: 1, expected pods: 1)"
+			if !strings.Contains(toolResult.Content[0].(mcp.TextContent).Text, expected) {
+				t.Errorf("expected %s, got %v", expected, toolResult.Content[0].(mcp.TextContent).Text)
+			}
+			if strings.Contains(toolResult.Content[0].(mcp.TextContent).Text, "unrelated-pdb") {
+				t.Errorf("unexpected unrelated PDB, got %v", toolResult.Content[0].(mcp.TextContent).Text)
+			}
+		})
+		t.Run("pods_evict with pod protected by PDB describes blocking PDB with empty selector", func(t *testing.T) {
+			expected := "PodDisruptionBudget namespace-pdb allows 0 disruptions (current healthy: 2, desired healthy: 2, expected pods: 2)"
+			if !strings.Contains(toolResult.Content[0].(mcp.TextContent).Text, expected) {
+				t.Errorf("expected %s, got %v", expected, toolResult.Content[0].(mcp.TextContent).Text)
+			}
+			if strings.Contains(toolResult.Content[0].(mcp.TextContent).Text, "invalid-pdb") {
+				t.Errorf("unexpected PDB without selector, got %v", toolResult.Content[0].(mcp.TextContent).Text)
+			}
+		})
+	})
+}
+
+func TestPodsEvictDenied(t *testing.T) {
+	deniedResourcesServer := &config.StaticConfig{DeniedResources: []config.GroupVersionKind{{Version: "v1", Kind: "Pod"}}}
+	testCaseWithContext(t, &mcpContext{staticConfig: deniedResourcesServer}, func(c *mcpContext) {
+		c.withEnvTest()
+		podsEvict, _ := c.callTool("pods_evict", map[string]interface{}{"name": "a-pod-in-default"})
+		t.Run("pods_evict has error", func(t *testing.T) {
+			if !podsEvict.IsError {
+				t.Fatalf("call tool should fail")
+			}
+		})
+		t.Run("pods_evict describes denial", func(t *testing.T) {
+			expectedMessage := "failed to evict pod a-pod-in-default in namespace : resource not allowed: /v1, Kind=Pod"
+			if podsEvict.Content[0].(mcp.TextContent).Text != expectedMessage {
+				t.Fatalf("expected descriptive error '%s', got %v", expectedMessage, podsEvict.Content[0].(mcp.TextContent).Text)
+			}
+		})
+	})
+}
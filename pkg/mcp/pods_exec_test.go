@@ -6,10 +6,13 @@ import (
 	"github.com/mark3labs/mcp-go/mcp"
 	"io"
 	v1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	remotecommandconsts "k8s.io/apimachinery/pkg/util/remotecommand"
 	"net/http"
 	"strings"
 	"testing"
+	"time"
 )
 
 func TestPodsExec(t *testing.T) {
@@ -101,6 +104,127 @@ func TestPodsExec(t *testing.T) {
 	})
 }
 
+func TestPodsExecStreamsAndExitCode(t *testing.T) {
+	testCase(t, func(c *mcpContext) {
+		mockServer := NewMockServer()
+		defer mockServer.Close()
+		c.withKubeConfig(mockServer.config)
+		mockServer.Handle(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+			if req.URL.Path != "/api/v1/namespaces/default/pods/pod-to-exec/exec" {
+				return
+			}
+			streamOptions := &StreamOptions{Stdout: &bytes.Buffer{}, Stderr: &bytes.Buffer{}}
+			if req.URL.Query().Get("stdin") == "true" {
+				streamOptions.Stdin = &bytes.Buffer{}
+			}
+			ctx, err := createHTTPStreams(w, req, streamOptions)
+			if err != nil {
+				w.WriteHeader(http.StatusInternalServerError)
+				_, _ = w.Write([]byte(err.Error()))
+				return
+			}
+			defer func(conn io.Closer) { _ = conn.Close() }(ctx.conn)
+			command := req.URL.Query()["command"]
+			switch command[0] {
+			case "cat":
+				stdin, _ := io.ReadAll(ctx.stdinStream)
+				_, _ = ctx.stdoutStream.Write(stdin)
+			case "grep":
+				_ = ctx.writeStatus(&apierrors.StatusError{ErrStatus: metav1.Status{
+					Status: metav1.StatusFailure,
+					Reason: remotecommandconsts.NonZeroExitCodeReason,
+					Details: &metav1.StatusDetails{Causes: []metav1.StatusCause{{
+						Type:    remotecommandconsts.ExitCodeCauseType,
+						Message: "1",
+					}}},
+				}})
+			case "warn":
+				_, _ = io.WriteString(ctx.stdoutStream, "some output\n")
+				_, _ = io.WriteString(ctx.stderrStream, "a warning\n")
+			case "slow":
+				_, _ = io.WriteString(ctx.stdoutStream, "partial output\n")
+				time.Sleep(1 * time.Second)
+			}
+		}))
+		mockServer.Handle(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+			if req.URL.Path != "/api/v1/namespaces/default/pods/pod-to-exec" {
+				return
+			}
+			writeObject(w, &v1.Pod{
+				ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "pod-to-exec"},
+				Spec:       v1.PodSpec{Containers: []v1.Container{{Name: "container-to-exec"}}},
+			})
+		}))
+		t.Run("pods_exec with stdin sends content to the command", func(t *testing.T) {
+			toolResult, err := c.callTool("pods_exec", map[string]interface{}{
+				"name":    "pod-to-exec",
+				"command": []interface{}{"cat"},
+				"stdin":   "hello from stdin",
+			})
+			if err != nil || toolResult.IsError {
+				t.Fatalf("call tool failed %v", err)
+			}
+			if toolResult.Content[0].(mcp.TextContent).Text != "hello from stdin" {
+				t.Errorf("unexpected result %v", toolResult.Content[0].(mcp.TextContent).Text)
+			}
+		})
+		t.Run("pods_exec with non-zero exit code returns successful result with exit code", func(t *testing.T) {
+			toolResult, err := c.callTool("pods_exec", map[string]interface{}{
+				"name":    "pod-to-exec",
+				"command": []interface{}{"grep", "not-found", "/etc/hosts"},
+			})
+			if err != nil || toolResult.IsError {
+				t.Fatalf("call tool failed %v", err)
+			}
+			if !strings.HasPrefix(toolResult.Content[0].(mcp.TextContent).Text, "The executed command in pod pod-to-exec in namespace  exited with code 1\n") {
+				t.Errorf("unexpected result %v", toolResult.Content[0].(mcp.TextContent).Text)
+			}
+		})
+		t.Run("pods_exec with stderr output returns both streams", func(t *testing.T) {
+			toolResult, err := c.callTool("pods_exec", map[string]interface{}{
+				"name":    "pod-to-exec",
+				"command": []interface{}{"warn"},
+			})
+			if err != nil || toolResult.IsError {
+				t.Fatalf("call tool failed %v", err)
+			}
+			expected := "The executed command in pod pod-to-exec in namespace  exited with code 0\n" +
+				"--- stdout ---\nsome output\n--- stderr ---\na warning\n"
+			if toolResult.Content[0].(mcp.TextContent).Text != expected {
+				t.Errorf("unexpected result %v", toolResult.Content[0].(mcp.TextContent).Text)
+			}
+		})
+		t.Run("pods_exec with timeout returns error with partial output", func(t *testing.T) {
+			toolResult, _ := c.callTool("pods_exec", map[string]interface{}{
+				"name":    "pod-to-exec",
+				"command": []interface{}{"slow"},
+				"timeout": "300ms",
+			})
+			if !toolResult.IsError {
+				t.Fatalf("call tool should fail")
+			}
+			expected := "failed to exec in pod pod-to-exec in namespace : command timed out after 300ms, output before the failure:\n" +
+				"--- stdout ---\npartial output\n--- stderr ---\n"
+			if toolResult.Content[0].(mcp.TextContent).Text != expected {
+				t.Errorf("unexpected result %v", toolResult.Content[0].(mcp.TextContent).Text)
+			}
+		})
+		t.Run("pods_exec with invalid timeout returns error", func(t *testing.T) {
+			toolResult, _ := c.callTool("pods_exec", map[string]interface{}{
+				"name":    "pod-to-exec",
+				"command": []interface{}{"ls"},
+				"timeout": "soon",
+			})
+			if !toolResult.IsError {
+				t.Fatalf("call tool should fail")
+			}
+			if toolResult.Content[0].(mcp.TextContent).Text != "failed to exec in pod, invalid argument timeout: soon" {
+				t.Errorf("unexpected result %v", toolResult.Content[0].(mcp.TextContent).Text)
+			}
+		})
+	})
+}
+
 func TestPodsExecDenied(t *testing.T) {
 	deniedResourcesServer := &config.StaticConfig{DeniedResources: []config.GroupVersionKind{{Version: "v1", Kind: "Pod"}}}
 	testCaseWithContext(t, &mcpContext{staticConfig: deniedResourcesServer}, func(c *mcpContext) {
@@ -0,0 +1,189 @@
+package mcp
+
+import (
+	"fmt"
+	"net"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/mark3labs/mcp-go/client"
+	"github.com/mark3labs/mcp-go/mcp"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/httpstream"
+	"k8s.io/apimachinery/pkg/util/httpstream/spdy"
+	"sigs.k8s.io/yaml"
+
+	"github.com/manusa/kubernetes-mcp-server/pkg/config"
+)
+
+func TestPodsPortForward(t *testing.T) {
+	testCase(t, func(c *mcpContext) {
+		mockServer := NewMockServer()
+		defer mockServer.Close()
+		c.withKubeConfig(mockServer.config)
+		mockServer.Handle(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+			if req.URL.Path != "/api/v1/namespaces/default/pods/pod-to-forward/portforward" || req.Method != http.MethodPost {
+				return
+			}
+			if _, err := httpstream.Handshake(req, w, []string{"portforward.k8s.io"}); err != nil {
+				return
+			}
+			conn := spdy.NewResponseUpgrader().UpgradeResponse(w, req, func(stream httpstream.Stream, replySent <-chan struct{}) error {
+				return nil
+			})
+			if conn != nil {
+				<-conn.CloseChan()
+			}
+		}))
+		mockServer.Handle(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+			switch req.URL.Path {
+			case "/api/v1/namespaces/default/pods/pod-to-forward":
+				writeObject(w, &v1.Pod{
+					ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "pod-to-forward"},
+					Spec:       v1.PodSpec{Containers: []v1.Container{{Name: "container"}}},
+					Status:     v1.PodStatus{Phase: v1.PodRunning},
+				})
+			case "/api/v1/namespaces/default/services/multi-port-service":
+				writeObject(w, &v1.Service{
+					ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "multi-port-service"},
+					Spec: v1.ServiceSpec{
+						Selector: map[string]string{"app": "multi-port"},
+						Ports:    []v1.ServicePort{{Name: "http", Port: 80}, {Name: "metrics", Port: 9090}},
+					},
+				})
+			case "/api/v1/namespaces/default/pods/pending-pod":
+				writeObject(w, &v1.Pod{
+					ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "pending-pod"},
+					Spec:       v1.PodSpec{Containers: []v1.Container{{Name: "container"}}},
+					Status:     v1.PodStatus{Phase: v1.PodPending},
+				})
+			}
+		}))
+		t.Run("pods_port_forward_start with no pod or service returns error", func(t *testing.T) {
+			toolResult, _ := c.callTool("pods_port_forward_start", map[string]interface{}{"remotePort": 8080})
+			if !toolResult.IsError {
+				t.Fatalf("call tool should fail")
+			}
+			if toolResult.Content[0].(mcp.TextContent).Text != "failed to start port-forward, missing argument name or service" {
+				t.Fatalf("invalid error message, got %v", toolResult.Content[0].(mcp.TextContent).Text)
+			}
+		})
+		t.Run("pods_port_forward_start with multi-port service and no remotePort returns error", func(t *testing.T) {
+			toolResult, _ := c.callTool("pods_port_forward_start", map[string]interface{}{"service": "multi-port-service"})
+			if !toolResult.IsError {
+				t.Fatalf("call tool should fail")
+			}
+			expected := "service multi-port-service exposes multiple ports (80, 9090), the remote port is required to select one of them"
+			if !strings.HasSuffix(toolResult.Content[0].(mcp.TextContent).Text, expected) {
+				t.Fatalf("invalid error message, got %v", toolResult.Content[0].(mcp.TextContent).Text)
+			}
+		})
+		t.Run("pods_port_forward_start with pod not running returns error", func(t *testing.T) {
+			toolResult, _ := c.callTool("pods_port_forward_start", map[string]interface{}{"name": "pending-pod", "remotePort": 8080})
+			if !toolResult.IsError {
+				t.Fatalf("call tool should fail")
+			}
+			if !strings.Contains(toolResult.Content[0].(mcp.TextContent).Text, "pod pending-pod is not running") {
+				t.Fatalf("invalid error message, got %v", toolResult.Content[0].(mcp.TextContent).Text)
+			}
+		})
+		toolResult, err := c.callTool("pods_port_forward_start", map[string]interface{}{"name": "pod-to-forward", "remotePort": 8080})
+		var session map[string]interface{}
+		t.Run("pods_port_forward_start starts session", func(t *testing.T) {
+			if err != nil || toolResult.IsError {
+				t.Fatalf("call tool failed %v %v", err, toolResult.Content)
+			}
+			if err = yaml.Unmarshal([]byte(toolResult.Content[0].(mcp.TextContent).Text), &session); err != nil {
+				t.Fatalf("invalid tool result content %v", err)
+			}
+			if session["pod"] != "pod-to-forward" || session["remotePort"] != float64(8080) {
+				t.Fatalf("unexpected session %v", session)
+			}
+		})
+		t.Run("pods_port_forward_start listens on local port", func(t *testing.T) {
+			conn, dialErr := net.Dial("tcp", fmt.Sprintf("127.0.0.1:%v", session["localPort"]))
+			if dialErr != nil {
+				t.Fatalf("failed to connect to local port %v", dialErr)
+			}
+			_ = conn.Close()
+		})
+		t.Run("pods_port_forward_list returns active session", func(t *testing.T) {
+			listResult, _ := c.callTool("pods_port_forward_list", map[string]interface{}{})
+			if listResult.IsError {
+				t.Fatalf("call tool failed")
+			}
+			if !strings.Contains(listResult.Content[0].(mcp.TextContent).Text, "id: "+session["id"].(string)) {
+				t.Fatalf("session not listed, got %v", listResult.Content[0].(mcp.TextContent).Text)
+			}
+		})
+		t.Run("pods_port_forward sessions are not visible to other MCP sessions", func(t *testing.T) {
+			otherClient, clientErr := client.NewSSEMCPClient(c.mcpHttpServer.URL + "/sse")
+			if clientErr != nil {
+				t.Fatalf("failed to create client %v", clientErr)
+			}
+			defer func() { _ = otherClient.Close() }()
+			if clientErr = otherClient.Start(c.ctx); clientErr != nil {
+				t.Fatalf("failed to start client %v", clientErr)
+			}
+			initRequest := mcp.InitializeRequest{}
+			initRequest.Params.ProtocolVersion = mcp.LATEST_PROTOCOL_VERSION
+			initRequest.Params.ClientInfo = mcp.Implementation{Name: "other", Version: "1.33.7"}
+			if _, clientErr = otherClient.Initialize(c.ctx, initRequest); clientErr != nil {
+				t.Fatalf("failed to initialize client %v", clientErr)
+			}
+			listRequest := mcp.CallToolRequest{}
+			listRequest.Params.Name = "pods_port_forward_list"
+			listResult, _ := otherClient.CallTool(c.ctx, listRequest)
+			if listResult.Content[0].(mcp.TextContent).Text != "No active port-forward sessions" {
+				t.Fatalf("session of another MCP session listed, got %v", listResult.Content[0].(mcp.TextContent).Text)
+			}
+			stopRequest := mcp.CallToolRequest{}
+			stopRequest.Params.Name = "pods_port_forward_stop"
+			stopRequest.Params.Arguments = map[string]interface{}{"id": session["id"]}
+			stopResult, _ := otherClient.CallTool(c.ctx, stopRequest)
+			if !stopResult.IsError {
+				t.Fatalf("session of another MCP session stopped")
+			}
+		})
+		t.Run("pods_port_forward_stop stops session", func(t *testing.T) {
+			stopResult, _ := c.callTool("pods_port_forward_stop", map[string]interface{}{"id": session["id"]})
+			if stopResult.IsError {
+				t.Fatalf("call tool failed %v", stopResult.Content[0].(mcp.TextContent).Text)
+			}
+			listResult, _ := c.callTool("pods_port_forward_list", map[string]interface{}{})
+			if listResult.Content[0].(mcp.TextContent).Text != "No active port-forward sessions" {
+				t.Fatalf("session still listed, got %v", listResult.Content[0].(mcp.TextContent).Text)
+			}
+		})
+		t.Run("pods_port_forward_stop with unknown id returns error", func(t *testing.T) {
+			stopResult, _ := c.callTool("pods_port_forward_stop", map[string]interface{}{"id": "pf-unknown"})
+			if !stopResult.IsError {
+				t.Fatalf("call tool should fail")
+			}
+			if stopResult.Content[0].(mcp.TextContent).Text != "failed to stop port-forward: port-forward session pf-unknown not found" {
+				t.Fatalf("invalid error message, got %v", stopResult.Content[0].(mcp.TextContent).Text)
+			}
+		})
+	})
+}
+
+func TestPodsPortForwardDenied(t *testing.T) {
+	deniedResourcesServer := &config.StaticConfig{DeniedResources: []config.GroupVersionKind{{Version: "v1", Kind: "Pod"}}}
+	testCaseWithContext(t, &mcpContext{staticConfig: deniedResourcesServer}, func(c *mcpContext) {
+		c.withEnvTest()
+		portForward, _ := c.callTool("pods_port_forward_start", map[string]interface{}{"name": "a-pod-in-default", "remotePort": 80})
+		t.Run("pods_port_forward_start has error", func(t *testing.T) {
+			if !portForward.IsError {
+				t.Fatalf("call tool should fail")
+			}
+		})
+		t.Run("pods_port_forward_start describes denial", func(t *testing.T) {
+			expectedMessage := "failed to start port-forward in namespace : resource not allowed: /v1, Kind=Pod"
+			if portForward.Content[0].(mcp.TextContent).Text != expectedMessage {
+				t.Fatalf("expected descriptive error '%s', got %v", expectedMessage, portForward.Content[0].(mcp.TextContent).Text)
+			}
+		})
+	})
+}
@@ -3,6 +3,8 @@ package mcp
 import (
 	"github.com/manusa/kubernetes-mcp-server/pkg/config"
 	"github.com/manusa/kubernetes-mcp-server/pkg/output"
+	"net/http"
+	"reflect"
 	"regexp"
 	"strings"
 	"testing"
@@ -740,6 +742,190 @@ func TestPodsLogDenied(t *testing.T) {
 	})
 }
 
+func TestPodsLogOptions(t *testing.T) {
+	testCase(t, func(c *mcpContext) {
+		mockServer := NewMockServer()
+		defer mockServer.Close()
+		c.withKubeConfig(mockServer.config)
+		mockServer.Handle(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+			if req.URL.Path != "/api/v1/namespaces/default/pods/pod-to-log/log" {
+				return
+			}
+			w.Header().Set("Content-Type", "text/plain")
+			_, _ = w.Write([]byte(req.URL.Query().Encode()))
+		}))
+		t.Run("pods_log with no options tails the last 256 lines", func(t *testing.T) {
+			toolResult, err := c.callTool("pods_log", map[string]interface{}{"name": "pod-to-log"})
+			if err != nil || toolResult.IsError {
+				t.Fatalf("call tool failed %v", err)
+				return
+			}
+			if toolResult.Content[0].(mcp.TextContent).Text != "tailLines=256" {
+				t.Fatalf("unexpected log options, got %v", toolResult.Content[0].(mcp.TextContent).Text)
+			}
+		})
+		t.Run("pods_log with previous, tail, timestamps, and limitBytes", func(t *testing.T) {
+			toolResult, err := c.callTool("pods_log", map[string]interface{}{
+				"name":       "pod-to-log",
+				"container":  "a-container",
+				"previous":   true,
+				"tail":       10,
+				"timestamps": true,
+				"limitBytes": 1024,
+			})
+			if err != nil || toolResult.IsError {
+				t.Fatalf("call tool failed %v", err)
+				return
+			}
+			expected := "container=a-container&limitBytes=1024&previous=true&tailLines=10&timestamps=true"
+			if toolResult.Content[0].(mcp.TextContent).Text != expected {
+				t.Fatalf("unexpected log options, expected %s, got %v", expected, toolResult.Content[0].(mcp.TextContent).Text)
+			}
+		})
+		t.Run("pods_log with since uses sinceSeconds and no default tail", func(t *testing.T) {
+			toolResult, err := c.callTool("pods_log", map[string]interface{}{"name": "pod-to-log", "since": "10m"})
+			if err != nil || toolResult.IsError {
+				t.Fatalf("call tool failed %v", err)
+				return
+			}
+			if toolResult.Content[0].(mcp.TextContent).Text != "sinceSeconds=600" {
+				t.Fatalf("unexpected log options, got %v", toolResult.Content[0].(mcp.TextContent).Text)
+			}
+		})
+		t.Run("pods_log with sinceTime", func(t *testing.T) {
+			toolResult, err := c.callTool("pods_log", map[string]interface{}{"name": "pod-to-log", "sinceTime": "2025-01-01T10:00:00Z"})
+			if err != nil || toolResult.IsError {
+				t.Fatalf("call tool failed %v", err)
+				return
+			}
+			if toolResult.Content[0].(mcp.TextContent).Text != "sinceTime=2025-01-01T10%3A00%3A00Z" {
+				t.Fatalf("unexpected log options, got %v", toolResult.Content[0].(mcp.TextContent).Text)
+			}
+		})
+		t.Run("pods_log with invalid since returns error", func(t *testing.T) {
+			toolResult, _ := c.callTool("pods_log", map[string]interface{}{"name": "pod-to-log", "since": "ten minutes"})
+			if !toolResult.IsError {
+				t.Fatalf("call tool should fail")
+				return
+			}
+			if toolResult.Content[0].(mcp.TextContent).Text != "failed to get pod log, invalid argument since: ten minutes" {
+				t.Fatalf("invalid error message, got %v", toolResult.Content[0].(mcp.TextContent).Text)
+			}
+		})
+		t.Run("pods_log with since and sinceTime returns error", func(t *testing.T) {
+			toolResult, _ := c.callTool("pods_log", map[string]interface{}{
+				"name":      "pod-to-log",
+				"since":     "10m",
+				"sinceTime": "2025-01-01T10:00:00Z",
+			})
+			if !toolResult.IsError {
+				t.Fatalf("call tool should fail")
+				return
+			}
+			if toolResult.Content[0].(mcp.TextContent).Text != "failed to get pod log, since and sinceTime are mutually exclusive" {
+				t.Fatalf("invalid error message, got %v", toolResult.Content[0].(mcp.TextContent).Text)
+			}
+		})
+	})
+}
+
+func TestPodsLogSelector(t *testing.T) {
+	testCase(t, func(c *mcpContext) {
+		mockServer := NewMockServer()
+		defer mockServer.Close()
+		c.withKubeConfig(mockServer.config)
+		mockServer.Handle(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+			w.Header().Set("Content-Type", "application/json")
+			switch req.URL.Path {
+			case "/api":
+				_, _ = w.Write([]byte(`{"kind":"APIVersions","versions":["v1"],"serverAddressByClientCIDRs":[{"clientCIDR":"0.0.0.0/0"}]}`))
+			case "/apis":
+				_, _ = w.Write([]byte(`{"kind":"APIGroupList","apiVersion":"v1","groups":[{"name":"apps","versions":[{"groupVersion":"apps/v1","version":"v1"}],"preferredVersion":{"groupVersion":"apps/v1","version":"v1"}}]}`))
+			case "/api/v1":
+				_, _ = w.Write([]byte(`{"kind":"APIResourceList","apiVersion":"v1","groupVersion":"v1","resources":[{"name":"pods","singularName":"","namespaced":true,"kind":"Pod","verbs":["get","list"]}]}`))
+			case "/apis/apps/v1":
+				_, _ = w.Write([]byte(`{"kind":"APIResourceList","apiVersion":"v1","groupVersion":"apps/v1","resources":[{"name":"deployments","singularName":"","namespaced":true,"kind":"Deployment","verbs":["get","list"]}]}`))
+			case "/apis/apps/v1/namespaces/default/deployments/a-deployment":
+				_, _ = w.Write([]byte(`{"apiVersion":"apps/v1","kind":"Deployment","metadata":{"name":"a-deployment","namespace":"default"},"spec":{"selector":{"matchLabels":{"app":"logger"}}}}`))
+			case "/api/v1/namespaces/default/pods":
+				if req.URL.Query().Get("labelSelector") != "app=logger" {
+					_, _ = w.Write([]byte(`{"apiVersion":"v1","kind":"PodList","items":[]}`))
+					return
+				}
+				_, _ = w.Write([]byte(`{"apiVersion":"v1","kind":"PodList","items":[` +
+					`{"apiVersion":"v1","kind":"Pod","metadata":{"name":"logger-1","namespace":"default"},"spec":{"containers":[{"name":"app"},{"name":"sidecar"}]}},` +
+					`{"apiVersion":"v1","kind":"Pod","metadata":{"name":"logger-2","namespace":"default"},"spec":{"containers":[{"name":"app"}]}}` +
+					`]}`))
+			case "/api/v1/namespaces/default/pods/logger-1/log":
+				w.Header().Set("Content-Type", "text/plain")
+				if req.URL.Query().Get("container") == "app" {
+					_, _ = w.Write([]byte("2025-01-01T10:00:01Z first\n2025-01-01T10:00:04Z fourth\n"))
+				} else {
+					_, _ = w.Write([]byte("2025-01-01T10:00:03Z third\n"))
+				}
+			case "/api/v1/namespaces/default/pods/logger-2/log":
+				w.Header().Set("Content-Type", "text/plain")
+				_, _ = w.Write([]byte("2025-01-01T10:00:02Z second\n"))
+			}
+		}))
+		t.Run("pods_log_selector with no selector or workload returns error", func(t *testing.T) {
+			toolResult, _ := c.callTool("pods_log_selector", map[string]interface{}{})
+			if !toolResult.IsError {
+				t.Fatalf("call tool should fail")
+				return
+			}
+			if toolResult.Content[0].(mcp.TextContent).Text != "failed to get pods log, missing argument labelSelector or kind and name" {
+				t.Fatalf("invalid error message, got %v", toolResult.Content[0].(mcp.TextContent).Text)
+			}
+		})
+		expected := "[logger-1/app] first\n[logger-2/app] second\n[logger-1/sidecar] third\n[logger-1/app] fourth\n"
+		t.Run("pods_log_selector with labelSelector returns merged logs", func(t *testing.T) {
+			toolResult, err := c.callTool("pods_log_selector", map[string]interface{}{"labelSelector": "app=logger"})
+			if err != nil || toolResult.IsError {
+				t.Fatalf("call tool failed %v", err)
+				return
+			}
+			if toolResult.Content[0].(mcp.TextContent).Text != expected {
+				t.Fatalf("unexpected logs, expected:\n%s\ngot:\n%s", expected, toolResult.Content[0].(mcp.TextContent).Text)
+			}
+		})
+		t.Run("pods_log_selector with Deployment returns merged logs", func(t *testing.T) {
+			toolResult, err := c.callTool("pods_log_selector", map[string]interface{}{"kind": "Deployment", "name": "a-deployment"})
+			if err != nil || toolResult.IsError {
+				t.Fatalf("call tool failed %v", err)
+				return
+			}
+			if toolResult.Content[0].(mcp.TextContent).Text != expected {
+				t.Fatalf("unexpected logs, expected:\n%s\ngot:\n%s", expected, toolResult.Content[0].(mcp.TextContent).Text)
+			}
+		})
+		t.Run("pods_log_selector with container and timestamps", func(t *testing.T) {
+			toolResult, err := c.callTool("pods_log_selector", map[string]interface{}{
+				"labelSelector": "app=logger",
+				"container":     "sidecar",
+				"timestamps":    true,
+			})
+			if err != nil || toolResult.IsError {
+				t.Fatalf("call tool failed %v", err)
+				return
+			}
+			if toolResult.Content[0].(mcp.TextContent).Text != "[logger-1/sidecar] 2025-01-01T10:00:03Z third\n" {
+				t.Fatalf("unexpected logs, got:\n%s", toolResult.Content[0].(mcp.TextContent).Text)
+			}
+		})
+		t.Run("pods_log_selector with unsupported kind returns error", func(t *testing.T) {
+			toolResult, _ := c.callTool("pods_log_selector", map[string]interface{}{"kind": "Service", "name": "a-service"})
+			if !toolResult.IsError {
+				t.Fatalf("call tool should fail")
+				return
+			}
+			if !strings.Contains(toolResult.Content[0].(mcp.TextContent).Text, "unsupported workload kind \"Service\"") {
+				t.Fatalf("invalid error message, got %v", toolResult.Content[0].(mcp.TextContent).Text)
+			}
+		})
+	})
+}
+
 func TestPodsRun(t *testing.T) {
 	testCase(t, func(c *mcpContext) {
 		c.withEnvTest()
@@ -890,6 +1076,92 @@ func TestPodsRun(t *testing.T) {
 	})
 }
 
+func TestPodsRunWithOptions(t *testing.T) {
+	testCase(t, func(c *mcpContext) {
+		c.withEnvTest()
+		t.Run("pods_run with invalid requests returns error", func(t *testing.T) {
+			toolResult, _ := c.callTool("pods_run", map[string]interface{}{"image": "busybox", "requests": map[string]interface{}{"cpu": "a-lot"}})
+			if !toolResult.IsError {
+				t.Fatalf("call tool should fail")
+			}
+			if !strings.HasPrefix(toolResult.Content[0].(mcp.TextContent).Text, "failed to run pod, invalid argument requests: cpu: ") {
+				t.Fatalf("invalid error message, got %v", toolResult.Content[0].(mcp.TextContent).Text)
+			}
+		})
+		podsRun, err := c.callTool("pods_run", map[string]interface{}{
+			"name":            "run-with-options",
+			"image":           "busybox",
+			"command":         []interface{}{"sh", "-c"},
+			"args":            []interface{}{"sleep 3600"},
+			"env":             map[string]interface{}{"LOG_LEVEL": "debug", "A_FIRST": "1"},
+			"requests":        map[string]interface{}{"cpu": "100m", "memory": "64Mi"},
+			"limits":          map[string]interface{}{"memory": "128Mi"},
+			"imagePullPolicy": "IfNotPresent",
+			"restartPolicy":   "Never",
+			"labels":          map[string]interface{}{"team": "sre"},
+			"serviceAccount":  "default",
+			"wait":            true,
+			"waitTimeout":     "1s",
+		})
+		t.Run("pods_run with options runs pod", func(t *testing.T) {
+			if err != nil || podsRun.IsError {
+				t.Fatalf("call tool failed %v %v", err, podsRun.Content)
+			}
+		})
+		documents := strings.Split(podsRun.Content[0].(mcp.TextContent).Text, "\n---\n")
+		t.Run("pods_run with options returns created resources and pod status", func(t *testing.T) {
+			if len(documents) != 2 {
+				t.Fatalf("expected resources and status documents, got %v", podsRun.Content[0].(mcp.TextContent).Text)
+			}
+		})
+		pod, err := c.newKubernetesClient().CoreV1().Pods("default").Get(c.ctx, "run-with-options", metav1.GetOptions{})
+		t.Run("pods_run with options sets container command, args, and env", func(t *testing.T) {
+			if err != nil {
+				t.Fatalf("failed to get pod %v", err)
+			}
+			container := pod.Spec.Containers[0]
+			if strings.Join(container.Command, " ") != "sh -c" || strings.Join(container.Args, " ") != "sleep 3600" {
+				t.Errorf("invalid command or args, got %v %v", container.Command, container.Args)
+			}
+			if len(container.Env) != 2 || container.Env[0].Name != "A_FIRST" || container.Env[1].Value != "debug" {
+				t.Errorf("invalid env, got %v", container.Env)
+			}
+			if container.ImagePullPolicy != corev1.PullIfNotPresent {
+				t.Errorf("invalid image pull policy, got %v", container.ImagePullPolicy)
+			}
+		})
+		t.Run("pods_run with options sets container resources", func(t *testing.T) {
+			container := pod.Spec.Containers[0]
+			if container.Resources.Requests.Cpu().String() != "100m" || container.Resources.Requests.Memory().String() != "64Mi" {
+				t.Errorf("invalid requests, got %v", container.Resources.Requests)
+			}
+			if container.Resources.Limits.Memory().String() != "128Mi" {
+				t.Errorf("invalid limits, got %v", container.Resources.Limits)
+			}
+		})
+		t.Run("pods_run with options sets pod restart policy, service account, and labels", func(t *testing.T) {
+			if pod.Spec.RestartPolicy != corev1.RestartPolicyNever {
+				t.Errorf("invalid restart policy, got %v", pod.Spec.RestartPolicy)
+			}
+			if pod.Spec.ServiceAccountName != "default" {
+				t.Errorf("invalid service account, got %v", pod.Spec.ServiceAccountName)
+			}
+			if pod.Labels["team"] != "sre" || pod.Labels["app.kubernetes.io/managed-by"] != "kubernetes-mcp-server" {
+				t.Errorf("invalid labels, got %v", pod.Labels)
+			}
+		})
+		t.Run("pods_run with wait reports timeout for pods that are never scheduled", func(t *testing.T) {
+			var status map[string]interface{}
+			if err = yaml.Unmarshal([]byte(documents[len(documents)-1]), &status); err != nil {
+				t.Fatalf("invalid pod status content %v", err)
+			}
+			if status["phase"] != "Pending" || status["reason"] != "Timeout" {
+				t.Errorf("invalid pod status, got %v", status)
+			}
+		})
+	})
+}
+
 func TestPodsRunDenied(t *testing.T) {
 	deniedResourcesServer := &config.StaticConfig{DeniedResources: []config.GroupVersionKind{{Version: "v1", Kind: "Pod"}}}
 	testCaseWithContext(t, &mcpContext{staticConfig: deniedResourcesServer}, func(c *mcpContext) {
@@ -1049,3 +1321,11 @@ func TestPodsListWithLabelSelector(t *testing.T) {
 		})
 	})
 }
+
+func TestStringMap(t *testing.T) {
+	ret := stringMap(map[string]interface{}{"string": "1e+06", "integer": float64(1000000), "decimal": 0.5, "bool": true})
+	expected := map[string]string{"string": "1e+06", "integer": "1000000", "decimal": "0.5", "bool": "true"}
+	if !reflect.DeepEqual(ret, expected) {
+		t.Errorf("unexpected string map, expected %v, got %v", expected, ret)
+	}
+}
@@ -205,6 +205,28 @@ func TestPodsTopMetricsAvailable(t *testing.T) {
 				t.Errorf("Expected total row '%s' not found in output:\n%s", expectedTotal.String(), textContent)
 			}
 		})
+		podsTopSortedLimited, err := c.callTool("pods_top", map[string]interface{}{
+			"containers": false,
+			"sort_by":    "memory",
+			"limit":      1,
+		})
+		t.Run("pods_top[containers=false,sort_by=memory,limit=1] returns top pod metrics", func(t *testing.T) {
+			if err != nil {
+				t.Fatalf("call tool failed %v", err)
+			}
+			textContent := podsTopSortedLimited.Content[0].(mcp.TextContent).Text
+			expectedHeaders := regexp.MustCompile(`(?m)^\s*NAMESPACE\s+NAME\s+CPU\(cores\)\s+MEMORY\(bytes\)\s*$`)
+			if !expectedHeaders.MatchString(textContent) {
+				t.Errorf("Expected headers '%s' not found in output:\n%s", expectedHeaders.String(), textContent)
+			}
+			expectedRow := regexp.MustCompile(`default\s+pod-1\s+300m\s+500Mi`)
+			if !expectedRow.MatchString(textContent) {
+				t.Errorf("Expected row '%s' not found in output:\n%s", expectedRow.String(), textContent)
+			}
+			if regexp.MustCompile(`pod-2`).MatchString(textContent) {
+				t.Errorf("Unexpected pod-2 row (limit=1) found in output:\n%s", textContent)
+			}
+		})
 	})
 }
 
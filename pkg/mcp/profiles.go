@@ -40,7 +40,10 @@ func (p *FullProfile) GetTools(s *Server) []server.ServerTool {
 		s.initConfiguration(),
 		s.initEvents(),
 		s.initNamespaces(),
+		s.initNodes(),
 		s.initPods(),
+		s.initRollout(),
+		s.initJobs(),
 		s.initResources(),
 		s.initHelm(),
 	)
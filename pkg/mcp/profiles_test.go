@@ -15,18 +15,45 @@ func TestFullProfileTools(t *testing.T) {
 		"helm_list",
 		"helm_uninstall",
 		"namespaces_list",
+		"nodes_top",
+		"nodes_cordon",
+		"nodes_uncordon",
+		"nodes_drain",
 		"pods_list",
 		"pods_list_in_namespace",
 		"pods_get",
 		"pods_delete",
+		"pods_evict",
 		"pods_top",
+		"pods_diagnose",
 		"pods_log",
+		"pods_log_selector",
+		"pods_port_forward_start",
+		"pods_port_forward_list",
+		"pods_port_forward_stop",
 		"pods_run",
 		"pods_exec",
+		"pods_debug",
+		"pods_cp_from",
+		"pods_cp_to",
+		"rollout_status",
+		"rollout_history",
+		"rollout_undo",
+		"rollout_restart",
+		"jobs_create_from_cronjob",
+		"jobs_status",
+		"cronjobs_suspend",
+		"cronjobs_resume",
+		"api_resources",
 		"resources_list",
 		"resources_get",
+		"resources_explain",
 		"resources_create_or_update",
+		"resources_patch",
 		"resources_delete",
+		"resources_scale",
+		"resources_wait",
+		"resources_tree",
 	}
 	mcpCtx := &mcpContext{profile: &FullProfile{}}
 	testCaseWithContext(t, mcpCtx, func(c *mcpContext) {
@@ -4,9 +4,14 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"strings"
+	"time"
 
 	"github.com/mark3labs/mcp-go/mcp"
 	"github.com/mark3labs/mcp-go/server"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 
 	"github.com/manusa/kubernetes-mcp-server/pkg/kubernetes"
@@ -20,21 +25,44 @@ func (s *Server) initResources() []server.ServerTool {
 	}
 	commonApiVersion = fmt.Sprintf("(common apiVersion and kind include: %s)", commonApiVersion)
 	return []server.ServerTool{
+		{Tool: mcp.NewTool("api_resources",
+			mcp.WithDescription("List the API resources supported by the current cluster, including custom resources (CRDs): "+
+				"their apiVersion, kind, resource name, short names, whether they are namespaced, and supported verbs. "+
+				"Use this tool to find the right apiVersion and kind before calling the resources_* tools"),
+			mcp.WithString("apiGroup", mcp.Description("Optional API group to filter the resources by (e.g. apps, networking.k8s.io, or core for the legacy core group)")),
+			mcp.WithArray("verbs", mcp.Description("Optional verbs that the resources must support (e.g. [\"list\", \"watch\"])"),
+				withStringArrayItems(),
+			),
+			// Tool annotations
+			mcp.WithTitleAnnotation("API Resources"),
+			mcp.WithReadOnlyHintAnnotation(true),
+			mcp.WithDestructiveHintAnnotation(false),
+			mcp.WithOpenWorldHintAnnotation(true),
+		), Handler: s.apiResources},
 		{Tool: mcp.NewTool("resources_list",
-			mcp.WithDescription("List Kubernetes resources and objects in the current cluster by providing their apiVersion and kind and optionally the namespace and label selector\n"+
+			mcp.WithDescription("List Kubernetes resources and objects in the current cluster by providing their apiVersion and kind (or kubectl-style resource name) and optionally the namespace and label selector\n"+
 				commonApiVersion),
 			mcp.WithString("apiVersion",
-				mcp.Description("apiVersion of the resources (examples of valid apiVersion are: v1, apps/v1, networking.k8s.io/v1)"),
-				mcp.Required(),
+				mcp.Description("apiVersion of the resources (examples of valid apiVersion are: v1, apps/v1, networking.k8s.io/v1), required unless resource is provided"),
 			),
 			mcp.WithString("kind",
-				mcp.Description("kind of the resources (examples of valid kind are: Pod, Service, Deployment, Ingress)"),
-				mcp.Required(),
+				mcp.Description("kind of the resources (examples of valid kind are: Pod, Service, Deployment, Ingress), required unless resource is provided"),
+			),
+			mcp.WithString("resource",
+				mcp.Description("Optional kubectl-style resource name, alternative to apiVersion and kind (examples of valid resource are: pods, po, svc, deploy, deployments.apps, certificates.cert-manager.io). "+
+					"Short names, plural and singular forms are resolved using the preferred version of the group unless provided (e.g. deployments.v1.apps)"),
 			),
 			mcp.WithString("namespace",
 				mcp.Description("Optional Namespace to retrieve the namespaced resources from (ignored in case of cluster scoped resources). If not provided, will list resources from all namespaces")),
 			mcp.WithString("labelSelector",
 				mcp.Description("Optional Kubernetes label selector (e.g. 'app=myapp,env=prod' or 'app in (myapp,yourapp)'), use this option when you want to filter the pods by label"), mcp.Pattern("([A-Za-z0-9][-A-Za-z0-9_.]*)?[A-Za-z0-9]")),
+			mcp.WithString("fieldSelector", mcp.Description("Optional Kubernetes field selector (e.g. 'status.phase!=Running' or 'spec.nodeName=node-1'), use this option when you want to filter the resources by field")),
+			mcp.WithNumber("limit", mcp.Description("Maximum number of resources to return, use with continue to paginate large result sets (Optional, all resources if not provided)")),
+			mcp.WithString("continue", mcp.Description("Optional continue token returned by a previous call with limit, use it to retrieve the next page of resources")),
+			mcp.WithString("jsonpath", mcp.Description("Optional kubectl JSONPath template to print only the selected values instead of the complete resource list (e.g. '{.items[*].metadata.name}'). Mutually exclusive with fields")),
+			mcp.WithArray("fields", mcp.Description("Optional list of field paths to print only the selected fields of each resource instead of the complete resources (e.g. [\"metadata.name\", \"spec.template.spec.containers[*].image\"]). Mutually exclusive with jsonpath"),
+				withStringArrayItems(),
+			),
 			// Tool annotations
 			mcp.WithTitleAnnotation("Resources: List"),
 			mcp.WithReadOnlyHintAnnotation(true),
@@ -42,26 +70,58 @@ func (s *Server) initResources() []server.ServerTool {
 			mcp.WithOpenWorldHintAnnotation(true),
 		), Handler: s.resourcesList},
 		{Tool: mcp.NewTool("resources_get",
-			mcp.WithDescription("Get a Kubernetes resource in the current cluster by providing its apiVersion, kind, optionally the namespace, and its name\n"+
+			mcp.WithDescription("Get a Kubernetes resource in the current cluster by providing its apiVersion and kind (or kubectl-style resource name), optionally the namespace, and its name\n"+
 				commonApiVersion),
 			mcp.WithString("apiVersion",
-				mcp.Description("apiVersion of the resource (examples of valid apiVersion are: v1, apps/v1, networking.k8s.io/v1)"),
-				mcp.Required(),
+				mcp.Description("apiVersion of the resource (examples of valid apiVersion are: v1, apps/v1, networking.k8s.io/v1), required unless resource is provided"),
 			),
 			mcp.WithString("kind",
-				mcp.Description("kind of the resource (examples of valid kind are: Pod, Service, Deployment, Ingress)"),
-				mcp.Required(),
+				mcp.Description("kind of the resource (examples of valid kind are: Pod, Service, Deployment, Ingress), required unless resource is provided"),
+			),
+			mcp.WithString("resource",
+				mcp.Description("Optional kubectl-style resource name, alternative to apiVersion and kind (examples of valid resource are: pods, po, svc, deploy, deployments.apps, certificates.cert-manager.io). "+
+					"Short names, plural and singular forms are resolved using the preferred version of the group unless provided (e.g. deployments.v1.apps)"),
 			),
 			mcp.WithString("namespace",
 				mcp.Description("Optional Namespace to retrieve the namespaced resource from (ignored in case of cluster scoped resources). If not provided, will get resource from configured namespace"),
 			),
 			mcp.WithString("name", mcp.Description("Name of the resource"), mcp.Required()),
+			mcp.WithString("jsonpath", mcp.Description("Optional kubectl JSONPath template to print only the selected values instead of the complete resource (e.g. '{.spec.replicas}'). Mutually exclusive with fields")),
+			mcp.WithArray("fields", mcp.Description("Optional list of field paths to print only the selected fields of the resource instead of the complete resource (e.g. [\"metadata.labels\", \"spec.template.spec.containers[*].image\"]). Mutually exclusive with jsonpath"),
+				withStringArrayItems(),
+			),
 			// Tool annotations
 			mcp.WithTitleAnnotation("Resources: Get"),
 			mcp.WithReadOnlyHintAnnotation(true),
 			mcp.WithDestructiveHintAnnotation(false),
 			mcp.WithOpenWorldHintAnnotation(true),
 		), Handler: s.resourcesGet},
+		{Tool: mcp.NewTool("resources_explain",
+			mcp.WithDescription("Explain the schema of a Kubernetes resource, or one of its fields, in the current cluster by providing its apiVersion and kind (or kubectl-style resource name) and optionally the field path. "+
+				"Returns the field type, description, whether it's required, and its child fields. "+
+				"Uses the OpenAPI v3 schema published by the cluster, so custom resources (CRDs) are supported too. "+
+				"Use this tool to learn the valid fields before creating or patching a resource\n"+
+				commonApiVersion),
+			mcp.WithString("apiVersion",
+				mcp.Description("apiVersion of the resource (examples of valid apiVersion are: v1, apps/v1, networking.k8s.io/v1), required unless resource is provided"),
+			),
+			mcp.WithString("kind",
+				mcp.Description("kind of the resource (examples of valid kind are: Pod, Service, Deployment, Ingress), required unless resource is provided"),
+			),
+			mcp.WithString("resource",
+				mcp.Description("Optional kubectl-style resource name, alternative to apiVersion and kind (examples of valid resource are: pods, po, svc, deploy, deployments.apps, certificates.cert-manager.io). "+
+					"kubectl explain style resource and field paths are also accepted (e.g. deployment.spec.template.spec.containers)"),
+			),
+			mcp.WithString("field",
+				mcp.Description("Optional dot-separated path of the field to explain (e.g. spec.template.spec.containers.livenessProbe). If not provided, the resource itself is explained"),
+			),
+			// Tool annotations
+			mcp.WithTitleAnnotation("Resources: Explain"),
+			mcp.WithReadOnlyHintAnnotation(true),
+			mcp.WithDestructiveHintAnnotation(false),
+			mcp.WithIdempotentHintAnnotation(true),
+			mcp.WithOpenWorldHintAnnotation(true),
+		), Handler: s.resourcesExplain},
 		{Tool: mcp.NewTool("resources_create_or_update",
 			mcp.WithDescription("Create or update a Kubernetes resource in the current cluster by providing a YAML or JSON representation of the resource\n"+
 				commonApiVersion),
@@ -69,6 +129,16 @@ func (s *Server) initResources() []server.ServerTool {
 				mcp.Description("A JSON or YAML containing a representation of the Kubernetes resource. Should include top-level fields such as apiVersion,kind,metadata, and spec"),
 				mcp.Required(),
 			),
+			mcp.WithBoolean("dryRun",
+				mcp.Description("Optional, perform a server-side dry-run, the resources are validated by the cluster but not persisted, returns the resulting resources (defaults to false)")),
+			mcp.WithBoolean("diff",
+				mcp.Description("Optional, perform a server-side dry-run and return a unified diff between the live resources and the result of the change, nothing is persisted. "+
+					"Use it to review exactly what a change will do before applying it (defaults to false)")),
+			mcp.WithBoolean("force",
+				mcp.Description("Optional, force the server-side apply taking ownership of the fields owned by other field managers (e.g. kubectl, ArgoCD, controllers). "+
+					"Only use it after reviewing the reported conflicts, since the other managers might revert the change (defaults to false)")),
+			mcp.WithString("fieldManager",
+				mcp.Description("Optional name of the field manager used for the server-side apply (defaults to kubernetes-mcp-server)")),
 			// Tool annotations
 			mcp.WithTitleAnnotation("Resources: Create or Update"),
 			mcp.WithReadOnlyHintAnnotation(false),
@@ -76,8 +146,9 @@ func (s *Server) initResources() []server.ServerTool {
 			mcp.WithIdempotentHintAnnotation(true),
 			mcp.WithOpenWorldHintAnnotation(true),
 		), Handler: s.resourcesCreateOrUpdate},
-		{Tool: mcp.NewTool("resources_delete",
-			mcp.WithDescription("Delete a Kubernetes resource in the current cluster by providing its apiVersion, kind, optionally the namespace, and its name\n"+
+		{Tool: mcp.NewTool("resources_patch",
+			mcp.WithDescription("Patch a Kubernetes resource, or one of its subresources, in the current cluster by providing its apiVersion, kind, optionally the namespace, its name, and the patch. "+
+				"Prefer this tool over resources_create_or_update for small targeted changes (e.g. add or remove an annotation, label, or finalizer)\n"+
 				commonApiVersion),
 			mcp.WithString("apiVersion",
 				mcp.Description("apiVersion of the resource (examples of valid apiVersion are: v1, apps/v1, networking.k8s.io/v1)"),
@@ -88,9 +159,65 @@ func (s *Server) initResources() []server.ServerTool {
 				mcp.Required(),
 			),
 			mcp.WithString("namespace",
-				mcp.Description("Optional Namespace to delete the namespaced resource from (ignored in case of cluster scoped resources). If not provided, will delete resource from configured namespace"),
+				mcp.Description("Optional Namespace of the namespaced resource (ignored in case of cluster scoped resources). If not provided, will patch resource from configured namespace"),
 			),
 			mcp.WithString("name", mcp.Description("Name of the resource"), mcp.Required()),
+			mcp.WithString("patch",
+				mcp.Description("The patch to apply in JSON or YAML format, its content depends on the patchType "+
+					"(e.g. json: [{\"op\":\"remove\",\"path\":\"/metadata/finalizers\"}], merge: {\"metadata\":{\"annotations\":{\"key\":\"value\"}}})"),
+				mcp.Required(),
+			),
+			mcp.WithString("patchType",
+				mcp.Description("Optional type of the patch (defaults to merge): "+
+					"json (JSON Patch, RFC 6902), merge (JSON Merge Patch, RFC 7386), strategic (strategic merge patch, only for built-in resources), "+
+					"or apply (server-side apply, the patch must include apiVersion and kind)"),
+				mcp.Enum("json", "merge", "strategic", "apply"),
+			),
+			mcp.WithString("subresource", mcp.Description("Optional subresource to patch (e.g. status, scale). If not provided, the main resource is patched")),
+			// Tool annotations
+			mcp.WithTitleAnnotation("Resources: Patch"),
+			mcp.WithReadOnlyHintAnnotation(false),
+			mcp.WithDestructiveHintAnnotation(true),
+			mcp.WithIdempotentHintAnnotation(false),
+			mcp.WithOpenWorldHintAnnotation(true),
+		), Handler: s.resourcesPatch},
+		{Tool: mcp.NewTool("resources_delete",
+			mcp.WithDescription("Delete a Kubernetes resource in the current cluster by providing its apiVersion and kind (or kubectl-style resource name), optionally the namespace, and its name. "+
+				"Alternatively, delete every resource of the kind matching a label or field selector in the namespace: "+
+				"the matching resources are returned first along with a confirmation token, they are only deleted when the tool is called again with the same arguments and the confirmationToken, "+
+				"and only if they are still exactly the reviewed resources\n"+
+				commonApiVersion),
+			mcp.WithString("apiVersion",
+				mcp.Description("apiVersion of the resource (examples of valid apiVersion are: v1, apps/v1, networking.k8s.io/v1), required unless resource is provided"),
+			),
+			mcp.WithString("kind",
+				mcp.Description("kind of the resource (examples of valid kind are: Pod, Service, Deployment, Ingress), required unless resource is provided"),
+			),
+			mcp.WithString("resource",
+				mcp.Description("Optional kubectl-style resource name, alternative to apiVersion and kind (examples of valid resource are: pods, po, svc, deploy, deployments.apps, certificates.cert-manager.io). "+
+					"Short names, plural and singular forms are resolved using the preferred version of the group unless provided (e.g. deployments.v1.apps)"),
+			),
+			mcp.WithString("namespace",
+				mcp.Description("Optional Namespace to delete the namespaced resource from (ignored in case of cluster scoped resources). If not provided, will delete resource from configured namespace"),
+			),
+			mcp.WithString("name", mcp.Description("Name of the resource (required unless labelSelector or fieldSelector is provided)")),
+			mcp.WithString("labelSelector",
+				mcp.Description("Optional Kubernetes label selector (e.g. 'app=myapp,env=prod'), deletes every resource of the kind matching the selector in the namespace instead of a single resource by name"),
+				mcp.Pattern("([A-Za-z0-9][-A-Za-z0-9_.]*)?[A-Za-z0-9]")),
+			mcp.WithString("fieldSelector",
+				mcp.Description("Optional Kubernetes field selector (e.g. 'status.phase=Failed'), deletes every resource of the kind matching the selector in the namespace instead of a single resource by name")),
+			mcp.WithString("confirmationToken",
+				mcp.Description("Optional, only applicable with labelSelector or fieldSelector. "+
+					"If not provided, returns the resources matching the selector and their confirmation token without deleting them. "+
+					"Provide the confirmation token returned for the matching resources only after reviewing them to delete them")),
+			mcp.WithString("propagationPolicy",
+				mcp.Description("Optional policy to delete the dependents of the resource (e.g. the Pods of a ReplicaSet): "+
+					"Foreground (dependents are deleted before the resource), Background (dependents are deleted after the resource), or Orphan (dependents are kept). "+
+					"If not provided, the default policy of the resource is used"),
+				mcp.Enum("Foreground", "Background", "Orphan"),
+			),
+			mcp.WithNumber("gracePeriodSeconds", mcp.Description("Optional duration in seconds before the resource is deleted, 0 deletes the resource immediately (if not provided, the default grace period of the resource is used)"), mcp.Min(0)),
+			mcp.WithBoolean("dryRun", mcp.Description("Optional, perform a server-side dry-run, nothing is deleted (defaults to false)")),
 			// Tool annotations
 			mcp.WithTitleAnnotation("Resources: Delete"),
 			mcp.WithReadOnlyHintAnnotation(false),
@@ -98,7 +225,114 @@ func (s *Server) initResources() []server.ServerTool {
 			mcp.WithIdempotentHintAnnotation(true),
 			mcp.WithOpenWorldHintAnnotation(true),
 		), Handler: s.resourcesDelete},
+		{Tool: mcp.NewTool("resources_scale",
+			mcp.WithDescription("Get or update the scale (number of replicas) of a Kubernetes resource in the current cluster by providing its apiVersion, kind, optionally the namespace, and its name. "+
+				"Works with any resource that provides a scale subresource (e.g. apps/v1 Deployment, apps/v1 StatefulSet, apps/v1 ReplicaSet, or custom resources). "+
+				"Only the replicas are updated, the rest of the resource is left untouched"),
+			mcp.WithString("apiVersion",
+				mcp.Description("apiVersion of the resource (examples of valid apiVersion are: apps/v1)"),
+				mcp.Required(),
+			),
+			mcp.WithString("kind",
+				mcp.Description("kind of the resource (examples of valid kind are: Deployment, StatefulSet, ReplicaSet)"),
+				mcp.Required(),
+			),
+			mcp.WithString("namespace",
+				mcp.Description("Optional Namespace of the namespaced resource (ignored in case of cluster scoped resources). If not provided, will scale resource from configured namespace"),
+			),
+			mcp.WithString("name", mcp.Description("Name of the resource"), mcp.Required()),
+			mcp.WithNumber("replicas", mcp.Description("Optional number of replicas to scale the resource to. If not provided, the current scale is returned"), mcp.Min(0)),
+			mcp.WithBoolean("wait", mcp.Description("Optional, wait until the ready replicas converge to the desired replicas (defaults to false)")),
+			mcp.WithString("timeout", mcp.Description("Optional maximum duration to wait for the replicas to converge like 30s or 5m (defaults to 2m, only applicable when wait is true)")),
+			// Tool annotations
+			mcp.WithTitleAnnotation("Resources: Scale"),
+			mcp.WithReadOnlyHintAnnotation(false),
+			mcp.WithDestructiveHintAnnotation(true),
+			mcp.WithIdempotentHintAnnotation(true),
+			mcp.WithOpenWorldHintAnnotation(true),
+		), Handler: s.resourcesScale},
+		{Tool: mcp.NewTool("resources_wait",
+			mcp.WithDescription("Wait for a Kubernetes resource, or every resource matching a label selector, in the current cluster to meet a condition, be deleted, or have a JSONPath value by providing their apiVersion, kind, optionally the namespace, and the condition to wait for. "+
+				"The resources are watched (no need to poll with resources_get) until the condition is met or the timeout expires, reporting which resources met the condition and which timed out\n"+
+				commonApiVersion),
+			mcp.WithString("apiVersion",
+				mcp.Description("apiVersion of the resources (examples of valid apiVersion are: v1, apps/v1, batch/v1)"),
+				mcp.Required(),
+			),
+			mcp.WithString("kind",
+				mcp.Description("kind of the resources (examples of valid kind are: Pod, Deployment, Job)"),
+				mcp.Required(),
+			),
+			mcp.WithString("namespace",
+				mcp.Description("Optional Namespace of the namespaced resources (ignored in case of cluster scoped resources). If not provided, will wait for resources in the configured namespace"),
+			),
+			mcp.WithString("name", mcp.Description("Optional name of the resource to wait for. If not provided, waits for all the resources matching the labelSelector")),
+			mcp.WithString("labelSelector",
+				mcp.Description("Optional Kubernetes label selector (e.g. 'app=myapp,env=prod'), only applicable when name is not provided"), mcp.Pattern("([A-Za-z0-9][-A-Za-z0-9_.]*)?[A-Za-z0-9]")),
+			mcp.WithString("for",
+				mcp.Description("Condition to wait for, same format as kubectl wait --for: "+
+					"'delete' (the resources are deleted), "+
+					"'condition=Type' or 'condition=Type=Value' (e.g. condition=Ready, condition=Available, condition=Complete, condition=Ready=False), "+
+					"'jsonpath={.path}' or 'jsonpath={.path}=value' (e.g. jsonpath={.status.phase}=Running)"),
+				mcp.Required(),
+			),
+			mcp.WithString("timeout", mcp.Description("Optional maximum duration to wait like 30s or 5m (defaults to 30s)")),
+			// Tool annotations
+			mcp.WithTitleAnnotation("Resources: Wait"),
+			mcp.WithReadOnlyHintAnnotation(true),
+			mcp.WithDestructiveHintAnnotation(false),
+			mcp.WithIdempotentHintAnnotation(true),
+			mcp.WithOpenWorldHintAnnotation(true),
+		), Handler: s.resourcesWait},
+		{Tool: mcp.NewTool("resources_tree",
+			mcp.WithDescription("Show the ownership tree of a Kubernetes resource in the current cluster (kubectl tree equivalent) by providing its apiVersion and kind (or kubectl-style resource name), optionally the namespace, and its name. "+
+				"The ownerReferences are followed upwards to find the top-level owner (e.g. Pod -> ReplicaSet -> Deployment), and all the resources owned by it are discovered downwards across all the listable resource kinds. "+
+				"Returns an indented tree with the readiness status of each resource, and the resource kinds that couldn't be listed (the tree may be incomplete). "+
+				"Use this tool to find out what created a resource and what else belongs to it before deleting it\n"+
+				commonApiVersion),
+			mcp.WithString("apiVersion",
+				mcp.Description("apiVersion of the resource (examples of valid apiVersion are: v1, apps/v1, networking.k8s.io/v1), required unless resource is provided"),
+			),
+			mcp.WithString("kind",
+				mcp.Description("kind of the resource (examples of valid kind are: Pod, Service, Deployment, Ingress), required unless resource is provided"),
+			),
+			mcp.WithString("resource",
+				mcp.Description("Optional kubectl-style resource name, alternative to apiVersion and kind (examples of valid resource are: pods, po, svc, deploy, deployments.apps, certificates.cert-manager.io). "+
+					"Short names, plural and singular forms are resolved using the preferred version of the group unless provided (e.g. deployments.v1.apps)"),
+			),
+			mcp.WithString("namespace",
+				mcp.Description("Optional Namespace of the resource (ignored in case of cluster scoped resources). If not provided, will use the configured namespace"),
+			),
+			mcp.WithString("name", mcp.Description("Name of the resource"), mcp.Required()),
+			// Tool annotations
+			mcp.WithTitleAnnotation("Resources: Tree"),
+			mcp.WithReadOnlyHintAnnotation(true),
+			mcp.WithDestructiveHintAnnotation(false),
+			mcp.WithIdempotentHintAnnotation(true),
+			mcp.WithOpenWorldHintAnnotation(true),
+		), Handler: s.resourcesTree},
+	}
+}
+
+func (s *Server) apiResources(ctx context.Context, ctr mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	apiResourcesOptions := kubernetes.APIResourcesOptions{Verbs: stringArray(ctr.GetArguments()["verbs"])}
+	if v, ok := ctr.GetArguments()["apiGroup"].(string); ok {
+		apiResourcesOptions.APIGroup = v
+	}
+
+	derived, err := s.k.Derived(ctx)
+	if err != nil {
+		return nil, err
 	}
+	ret, err := derived.APIResources(ctx, apiResourcesOptions)
+	if err != nil {
+		return NewTextResult("", fmt.Errorf("failed to list API resources: %v", err)), nil
+	}
+	marshalledYaml, err := output.MarshalYaml(ret)
+	if err != nil {
+		err = fmt.Errorf("failed to list API resources: %v", err)
+	}
+	return NewTextResult("# The following API resources (YAML) are supported by the cluster\n"+marshalledYaml, err), nil
 }
 
 func (s *Server) resourcesList(ctx context.Context, ctr mcp.CallToolRequest) (*mcp.CallToolResult, error) {
@@ -106,19 +340,19 @@ func (s *Server) resourcesList(ctx context.Context, ctr mcp.CallToolRequest) (*m
 	if namespace == nil {
 		namespace = ""
 	}
-	labelSelector := ctr.GetArguments()["labelSelector"]
-	resourceListOptions := kubernetes.ResourceListOptions{
-		AsTable: s.configuration.ListOutput.AsTable(),
+	p, err := parseProjection(ctr.GetArguments())
+	if err != nil {
+		return NewTextResult("", fmt.Errorf("failed to list resources, %s", err)), nil
 	}
-
-	if labelSelector != nil {
-		l, ok := labelSelector.(string)
-		if !ok {
-			return NewTextResult("", fmt.Errorf("labelSelector is not a string")), nil
-		}
-		resourceListOptions.LabelSelector = l
+	resourceListOptions, err := s.resourceListOptions(ctr.GetArguments())
+	if err != nil {
+		return NewTextResult("", err), nil
 	}
-	gvk, err := parseGroupVersionKind(ctr.GetArguments())
+	derived, err := s.k.Derived(ctx)
+	if err != nil {
+		return nil, err
+	}
+	gvk, err := resolveGroupVersionKind(derived, ctr.GetArguments())
 	if err != nil {
 		return NewTextResult("", fmt.Errorf("failed to list resources, %s", err)), nil
 	}
@@ -128,15 +362,11 @@ func (s *Server) resourcesList(ctx context.Context, ctr mcp.CallToolRequest) (*m
 		return NewTextResult("", fmt.Errorf("namespace is not a string")), nil
 	}
 
-	derived, err := s.k.Derived(ctx)
-	if err != nil {
-		return nil, err
-	}
 	ret, err := derived.ResourcesList(ctx, gvk, ns, resourceListOptions)
 	if err != nil {
 		return NewTextResult("", fmt.Errorf("failed to list resources: %v", err)), nil
 	}
-	return NewTextResult(s.configuration.ListOutput.PrintObj(ret)), nil
+	return NewTextResult(s.printList(ret, p)), nil
 }
 
 func (s *Server) resourcesGet(ctx context.Context, ctr mcp.CallToolRequest) (*mcp.CallToolResult, error) {
@@ -144,7 +374,15 @@ func (s *Server) resourcesGet(ctx context.Context, ctr mcp.CallToolRequest) (*mc
 	if namespace == nil {
 		namespace = ""
 	}
-	gvk, err := parseGroupVersionKind(ctr.GetArguments())
+	p, err := parseProjection(ctr.GetArguments())
+	if err != nil {
+		return NewTextResult("", fmt.Errorf("failed to get resource, %s", err)), nil
+	}
+	derived, err := s.k.Derived(ctx)
+	if err != nil {
+		return nil, err
+	}
+	gvk, err := resolveGroupVersionKind(derived, ctr.GetArguments())
 	if err != nil {
 		return NewTextResult("", fmt.Errorf("failed to get resource, %s", err)), nil
 	}
@@ -163,13 +401,32 @@ func (s *Server) resourcesGet(ctx context.Context, ctr mcp.CallToolRequest) (*mc
 		return NewTextResult("", fmt.Errorf("name is not a string")), nil
 	}
 
+	ret, err := derived.ResourcesGet(ctx, gvk, ns, n)
+	if err != nil {
+		return NewTextResult("", fmt.Errorf("failed to get resource: %v", err)), nil
+	}
+	return NewTextResult(printObject(ret, p)), nil
+}
+
+func (s *Server) resourcesExplain(ctx context.Context, ctr mcp.CallToolRequest) (*mcp.CallToolResult, error) {
 	derived, err := s.k.Derived(ctx)
 	if err != nil {
 		return nil, err
 	}
-	ret, err := derived.ResourcesGet(ctx, gvk, ns, n)
+	arguments := ctr.GetArguments()
+	field, _ := arguments["field"].(string)
+	gvk, err := resolveGroupVersionKind(derived, arguments)
+	// kubectl explain style resource and field path (e.g. deployment.spec.template)
+	if resource, ok := arguments["resource"].(string); err != nil && ok && field == "" && strings.Contains(resource, ".") {
+		resource, field, _ = strings.Cut(resource, ".")
+		gvk, err = derived.ResourceGroupVersionKind(resource)
+	}
 	if err != nil {
-		return NewTextResult("", fmt.Errorf("failed to get resource: %v", err)), nil
+		return NewTextResult("", fmt.Errorf("failed to explain resource, %s", err)), nil
+	}
+	ret, err := derived.ResourcesExplain(ctx, gvk, field)
+	if err != nil {
+		return NewTextResult("", fmt.Errorf("failed to explain resource: %v", err)), nil
 	}
 	return NewTextResult(output.MarshalYaml(ret)), nil
 }
@@ -189,50 +446,457 @@ func (s *Server) resourcesCreateOrUpdate(ctx context.Context, ctr mcp.CallToolRe
 	if err != nil {
 		return nil, err
 	}
-	resources, err := derived.ResourcesCreateOrUpdate(ctx, r)
+	resourcesCreateOrUpdateOptions := kubernetes.ResourcesCreateOrUpdateOptions{}
+	if v, ok := ctr.GetArguments()["force"].(bool); ok {
+		resourcesCreateOrUpdateOptions.Force = v
+	}
+	if v, ok := ctr.GetArguments()["fieldManager"].(string); ok {
+		resourcesCreateOrUpdateOptions.FieldManager = v
+	}
+	if v, ok := ctr.GetArguments()["diff"].(bool); ok && v {
+		diff, err := derived.ResourcesDiff(ctx, r, resourcesCreateOrUpdateOptions)
+		if err != nil {
+			return NewTextResult("", resourcesApplyError("failed to diff resources", err)), nil
+		}
+		if diff == "" {
+			return NewTextResult("# No differences between the live resources and the result of the server dry-run (nothing was persisted)\n", nil), nil
+		}
+		return NewTextResult("# Unified diff between the live resources and the result of the server dry-run (nothing was persisted)\n"+diff, nil), nil
+	}
+	if v, ok := ctr.GetArguments()["dryRun"].(bool); ok {
+		resourcesCreateOrUpdateOptions.DryRun = v
+	}
+	resources, err := derived.ResourcesCreateOrUpdate(ctx, r, resourcesCreateOrUpdateOptions)
 	if err != nil {
-		return NewTextResult("", fmt.Errorf("failed to create or update resources: %v", err)), nil
+		return NewTextResult("", resourcesApplyError("failed to create or update resources", err)), nil
 	}
 	marshalledYaml, err := output.MarshalYaml(resources)
 	if err != nil {
 		err = fmt.Errorf("failed to create or update resources:: %v", err)
 	}
+	if resourcesCreateOrUpdateOptions.DryRun {
+		return NewTextResult("# The following resources (YAML) would be created or updated (server dry-run, nothing was persisted)\n"+marshalledYaml, err), nil
+	}
 	return NewTextResult("# The following resources (YAML) have been created or updated successfully\n"+marshalledYaml, err), nil
 }
 
+func (s *Server) resourcesPatch(ctx context.Context, ctr mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	ns := ""
+	if v, ok := ctr.GetArguments()["namespace"].(string); ok {
+		ns = v
+	}
+	gvk, err := parseGroupVersionKind(ctr.GetArguments())
+	if err != nil {
+		return NewTextResult("", fmt.Errorf("failed to patch resource, %s", err)), nil
+	}
+	name, ok := ctr.GetArguments()["name"].(string)
+	if !ok || name == "" {
+		return NewTextResult("", errors.New("failed to patch resource, missing argument name")), nil
+	}
+	patch, ok := ctr.GetArguments()["patch"].(string)
+	if !ok || patch == "" {
+		return NewTextResult("", errors.New("failed to patch resource, missing argument patch")), nil
+	}
+	patchType := "merge"
+	if v, ok := ctr.GetArguments()["patchType"].(string); ok && v != "" {
+		patchType = v
+	}
+	subresource := ""
+	if v, ok := ctr.GetArguments()["subresource"].(string); ok {
+		subresource = v
+	}
+
+	derived, err := s.k.Derived(ctx)
+	if err != nil {
+		return nil, err
+	}
+	ret, err := derived.ResourcesPatch(ctx, gvk, ns, name, patchType, patch, subresource)
+	if err != nil {
+		return NewTextResult("", fmt.Errorf("failed to patch resource: %v", err)), nil
+	}
+	marshalledYaml, err := output.MarshalYaml(ret)
+	if err != nil {
+		err = fmt.Errorf("failed to patch resource: %v", err)
+	}
+	return NewTextResult("# The following resource (YAML) has been patched successfully\n"+marshalledYaml, err), nil
+}
+
 func (s *Server) resourcesDelete(ctx context.Context, ctr mcp.CallToolRequest) (*mcp.CallToolResult, error) {
 	namespace := ctr.GetArguments()["namespace"]
 	if namespace == nil {
 		namespace = ""
 	}
-	gvk, err := parseGroupVersionKind(ctr.GetArguments())
+	derived, err := s.k.Derived(ctx)
+	if err != nil {
+		return nil, err
+	}
+	gvk, err := resolveGroupVersionKind(derived, ctr.GetArguments())
 	if err != nil {
 		return NewTextResult("", fmt.Errorf("failed to delete resource, %s", err)), nil
 	}
+	listOptions := metav1.ListOptions{}
+	if v, ok := ctr.GetArguments()["labelSelector"].(string); ok {
+		listOptions.LabelSelector = v
+	}
+	if v, ok := ctr.GetArguments()["fieldSelector"].(string); ok {
+		listOptions.FieldSelector = v
+	}
+	bySelector := listOptions.LabelSelector != "" || listOptions.FieldSelector != ""
 	name := ctr.GetArguments()["name"]
-	if name == nil {
+	if name == nil && !bySelector {
 		return NewTextResult("", errors.New("failed to delete resource, missing argument name")), nil
 	}
+	if name != nil && name != "" && bySelector {
+		return NewTextResult("", errors.New("failed to delete resource, name and labelSelector or fieldSelector are mutually exclusive")), nil
+	}
 
 	ns, ok := namespace.(string)
 	if !ok {
 		return NewTextResult("", fmt.Errorf("namespace is not a string")), nil
 	}
 
+	resourcesDeleteOptions := kubernetes.ResourcesDeleteOptions{}
+	if v, ok := ctr.GetArguments()["propagationPolicy"].(string); ok {
+		resourcesDeleteOptions.PropagationPolicy = v
+	}
+	if v, ok := ctr.GetArguments()["gracePeriodSeconds"].(float64); ok {
+		if v < 0 || v != float64(int64(v)) {
+			return NewTextResult("", fmt.Errorf("failed to delete resource, invalid argument gracePeriodSeconds: %v", v)), nil
+		}
+		gracePeriodSeconds := int64(v)
+		resourcesDeleteOptions.GracePeriodSeconds = &gracePeriodSeconds
+	}
+	if v, ok := ctr.GetArguments()["dryRun"].(bool); ok {
+		resourcesDeleteOptions.DryRun = v
+	}
+
+	if bySelector {
+		confirmationToken, _ := ctr.GetArguments()["confirmationToken"].(string)
+		return s.resourcesDeleteBySelector(ctx, derived, gvk, ns, listOptions, resourcesDeleteOptions, confirmationToken)
+	}
+
 	n, ok := name.(string)
 	if !ok {
 		return NewTextResult("", fmt.Errorf("name is not a string")), nil
 	}
+	err = derived.ResourcesDelete(ctx, gvk, ns, n, resourcesDeleteOptions)
+	if err != nil {
+		return NewTextResult("", fmt.Errorf("failed to delete resource: %v", err)), nil
+	}
+	if resourcesDeleteOptions.DryRun {
+		return NewTextResult("Resource would be deleted (server dry-run, nothing was deleted)", nil), nil
+	}
+	return NewTextResult("Resource deleted successfully", err), nil
+}
+
+func (s *Server) resourcesDeleteBySelector(ctx context.Context, derived *kubernetes.Kubernetes, gvk *schema.GroupVersionKind, ns string, listOptions metav1.ListOptions, resourcesDeleteOptions kubernetes.ResourcesDeleteOptions, confirmationToken string) (*mcp.CallToolResult, error) {
+	names, token, err := derived.ResourcesDeleteBySelector(ctx, gvk, ns, listOptions, resourcesDeleteOptions, confirmationToken)
+	if err != nil && len(names) == 0 {
+		return NewTextResult("", fmt.Errorf("failed to delete resources: %v", err)), nil
+	}
+	if len(names) == 0 {
+		return NewTextResult("No resources match the selector, nothing was deleted", nil), nil
+	}
+	marshalledYaml, mErr := output.MarshalYaml(names)
+	if mErr != nil {
+		return NewTextResult("", fmt.Errorf("failed to delete resources: %v", mErr)), nil
+	}
+	switch {
+	case errors.Is(err, kubernetes.ErrResourcesDeleteConfirmationMismatch):
+		return NewTextResult("", fmt.Errorf("failed to delete resources: %v\n# The following %d %s resources (YAML) match the selector now. "+
+			"Review them and call resources_delete again with the same arguments and confirmationToken set to %s to delete them\n%s", err, len(names), gvk.Kind, token, marshalledYaml)), nil
+	case err != nil:
+		return NewTextResult("", fmt.Errorf("failed to delete resources: %v\n# The following resources (YAML) have been deleted\n%s", err, marshalledYaml)), nil
+	case confirmationToken == "":
+		return NewTextResult(fmt.Sprintf("# The following %d %s resources (YAML) match the selector and would be deleted. "+
+			"Review them and call resources_delete again with the same arguments and confirmationToken set to %s to delete them\n%s", len(names), gvk.Kind, token, marshalledYaml), nil), nil
+	case resourcesDeleteOptions.DryRun:
+		return NewTextResult("# The following resources (YAML) would be deleted (server dry-run, nothing was deleted)\n"+marshalledYaml, nil), nil
+	}
+	return NewTextResult("# The following resources (YAML) have been deleted successfully\n"+marshalledYaml, nil), nil
+}
+
+func (s *Server) resourcesScale(ctx context.Context, ctr mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	ns := ""
+	if v, ok := ctr.GetArguments()["namespace"].(string); ok {
+		ns = v
+	}
+	gvk, err := parseGroupVersionKind(ctr.GetArguments())
+	if err != nil {
+		return NewTextResult("", fmt.Errorf("failed to scale resource, %s", err)), nil
+	}
+	name, ok := ctr.GetArguments()["name"].(string)
+	if !ok || name == "" {
+		return NewTextResult("", errors.New("failed to scale resource, missing argument name")), nil
+	}
+	resourcesScaleOptions := kubernetes.ResourcesScaleOptions{}
+	if v, ok := ctr.GetArguments()["replicas"].(float64); ok {
+		if v < 0 || v != float64(int64(v)) {
+			return NewTextResult("", fmt.Errorf("failed to scale resource, invalid argument replicas: %v", v)), nil
+		}
+		replicas := int64(v)
+		resourcesScaleOptions.Replicas = &replicas
+	}
+	if v, ok := ctr.GetArguments()["wait"].(bool); ok && v {
+		resourcesScaleOptions.Timeout = 2 * time.Minute
+		if t, ok := ctr.GetArguments()["timeout"].(string); ok && t != "" {
+			if resourcesScaleOptions.Timeout, err = time.ParseDuration(t); err != nil || resourcesScaleOptions.Timeout <= 0 {
+				return NewTextResult("", fmt.Errorf("failed to scale resource, invalid argument timeout: %s", t)), nil
+			}
+		}
+	}
 
 	derived, err := s.k.Derived(ctx)
 	if err != nil {
 		return nil, err
 	}
-	err = derived.ResourcesDelete(ctx, gvk, ns, n)
+	ret, err := derived.ResourcesScale(ctx, gvk, ns, name, resourcesScaleOptions)
 	if err != nil {
-		return NewTextResult("", fmt.Errorf("failed to delete resource: %v", err)), nil
+		return NewTextResult("", fmt.Errorf("failed to scale resource: %v", err)), nil
 	}
-	return NewTextResult("Resource deleted successfully", err), nil
+	marshalledYaml, err := output.MarshalYaml(ret)
+	if err != nil {
+		err = fmt.Errorf("failed to scale resource: %v", err)
+	}
+	if resourcesScaleOptions.Replicas == nil {
+		return NewTextResult("# The current scale of the resource (YAML)\n"+marshalledYaml, err), nil
+	}
+	return NewTextResult("# The resource has been scaled successfully, its previous and new scale (YAML)\n"+marshalledYaml, err), nil
+}
+
+func (s *Server) resourcesWait(ctx context.Context, ctr mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	ns := ""
+	if v, ok := ctr.GetArguments()["namespace"].(string); ok {
+		ns = v
+	}
+	gvk, err := parseGroupVersionKind(ctr.GetArguments())
+	if err != nil {
+		return NewTextResult("", fmt.Errorf("failed to wait for resources, %s", err)), nil
+	}
+	resourcesWaitOptions := kubernetes.ResourcesWaitOptions{Timeout: 30 * time.Second}
+	if v, ok := ctr.GetArguments()["for"].(string); ok {
+		resourcesWaitOptions.For = v
+	}
+	if resourcesWaitOptions.For == "" {
+		return NewTextResult("", errors.New("failed to wait for resources, missing argument for")), nil
+	}
+	if v, ok := ctr.GetArguments()["name"].(string); ok {
+		resourcesWaitOptions.Name = v
+	}
+	if v, ok := ctr.GetArguments()["labelSelector"].(string); ok {
+		resourcesWaitOptions.LabelSelector = v
+	}
+	if t, ok := ctr.GetArguments()["timeout"].(string); ok && t != "" {
+		if resourcesWaitOptions.Timeout, err = time.ParseDuration(t); err != nil || resourcesWaitOptions.Timeout <= 0 {
+			return NewTextResult("", fmt.Errorf("failed to wait for resources, invalid argument timeout: %s", t)), nil
+		}
+	}
+	resourcesWaitOptions.OnProgress = func(met, total int, message string) {
+		sendProgressNotification(ctx, ctr, met, total, message)
+	}
+
+	derived, err := s.k.Derived(ctx)
+	if err != nil {
+		return nil, err
+	}
+	ret, err := derived.ResourcesWait(ctx, gvk, ns, resourcesWaitOptions)
+	if err != nil {
+		return NewTextResult("", fmt.Errorf("failed to wait for resources: %v", err)), nil
+	}
+	marshalledYaml, err := output.MarshalYaml(ret)
+	if err != nil {
+		return NewTextResult("", fmt.Errorf("failed to wait for resources: %v", err)), nil
+	}
+	if len(ret.TimedOut) > 0 || len(ret.Failed) > 0 {
+		return NewTextResult("", fmt.Errorf("failed to wait for resources, not all the resources met the condition %s (YAML)\n%s", resourcesWaitOptions.For, marshalledYaml)), nil
+	}
+	return NewTextResult("# All the resources met the condition "+resourcesWaitOptions.For+" (YAML)\n"+marshalledYaml, nil), nil
+}
+
+func (s *Server) resourcesTree(ctx context.Context, ctr mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	ns := ""
+	if v, ok := ctr.GetArguments()["namespace"].(string); ok {
+		ns = v
+	}
+	name, ok := ctr.GetArguments()["name"].(string)
+	if !ok || name == "" {
+		return NewTextResult("", errors.New("failed to get resource tree, missing argument name")), nil
+	}
+	derived, err := s.k.Derived(ctx)
+	if err != nil {
+		return nil, err
+	}
+	gvk, err := resolveGroupVersionKind(derived, ctr.GetArguments())
+	if err != nil {
+		return NewTextResult("", fmt.Errorf("failed to get resource tree, %s", err)), nil
+	}
+	ret, failed, err := derived.ResourcesTree(ctx, gvk, ns, name)
+	if err != nil {
+		return NewTextResult("", fmt.Errorf("failed to get resource tree: %v", err)), nil
+	}
+	sb := strings.Builder{}
+	sb.WriteString("# Ownership tree of the resource (marked with *), from its top-level owner down to all of its dependents\n")
+	printResourcesTree(&sb, ret, ret.Namespace, "", "")
+	if len(failed) > 0 {
+		failedYaml, mErr := output.MarshalYaml(failed)
+		if mErr != nil {
+			return NewTextResult("", fmt.Errorf("failed to get resource tree: %v", mErr)), nil
+		}
+		sb.WriteString("# The tree may be incomplete, the dependents couldn't be discovered in the following resources (YAML)\n")
+		sb.WriteString(failedYaml)
+	}
+	return NewTextResult(sb.String(), nil), nil
+}
+
+// printResourcesTree prints the node and its children as an indented tree (similar to kubectl tree), e.g.:
+//
+//	Deployment.apps/web (namespace: default) [Available]
+//	└── ReplicaSet.apps/web-5d8c7f9b6 [Ready 1/1]
+//	    └── Pod/web-5d8c7f9b6-x2x4z [Ready] *
+func printResourcesTree(sb *strings.Builder, node *kubernetes.ResourcesTreeNode, rootNamespace, prefix, childrenPrefix string) {
+	sb.WriteString(prefix)
+	sb.WriteString(node.Kind)
+	if gv, err := schema.ParseGroupVersion(node.APIVersion); err == nil && gv.Group != "" {
+		sb.WriteString("." + gv.Group)
+	}
+	sb.WriteString("/" + node.Name)
+	if node.Namespace != "" && (prefix == "" || node.Namespace != rootNamespace) {
+		sb.WriteString(" (namespace: " + node.Namespace + ")")
+	}
+	status := node.Status
+	if status == "" {
+		status = "-"
+	}
+	sb.WriteString(" [" + status + "]")
+	if node.Requested {
+		sb.WriteString(" *")
+	}
+	sb.WriteString("\n")
+	for i, child := range node.Children {
+		if i == len(node.Children)-1 {
+			printResourcesTree(sb, child, rootNamespace, childrenPrefix+"└── ", childrenPrefix+"    ")
+		} else {
+			printResourcesTree(sb, child, rootNamespace, childrenPrefix+"├── ", childrenPrefix+"│   ")
+		}
+	}
+}
+
+// resourcesApplyError describes the server-side apply error, field manager conflicts are reported in a structured way (YAML)
+// so that they can be reviewed before deliberately retrying with force
+func resourcesApplyError(message string, err error) error {
+	var conflictErr *kubernetes.ResourcesApplyConflictError
+	if !errors.As(err, &conflictErr) {
+		return fmt.Errorf("%s: %v", message, err)
+	}
+	report, mErr := output.MarshalYaml(conflictErr)
+	if mErr != nil {
+		return fmt.Errorf("%s: %v", message, err)
+	}
+	return fmt.Errorf("%s, the following fields are owned by other field managers (YAML). "+
+		"Either update the resource to match the values set by the other managers, or retry with force to take ownership of the fields\n%s", message, report)
+}
+
+// resourceListOptions parses the common list arguments (labelSelector, fieldSelector, limit, and continue)
+func (s *Server) resourceListOptions(arguments map[string]interface{}) (kubernetes.ResourceListOptions, error) {
+	resourceListOptions := kubernetes.ResourceListOptions{
+		AsTable: s.configuration.ListOutput.AsTable(),
+	}
+	if labelSelector := arguments["labelSelector"]; labelSelector != nil {
+		l, ok := labelSelector.(string)
+		if !ok {
+			return resourceListOptions, fmt.Errorf("labelSelector is not a string")
+		}
+		resourceListOptions.LabelSelector = l
+	}
+	if fieldSelector := arguments["fieldSelector"]; fieldSelector != nil {
+		f, ok := fieldSelector.(string)
+		if !ok {
+			return resourceListOptions, fmt.Errorf("fieldSelector is not a string")
+		}
+		resourceListOptions.FieldSelector = f
+	}
+	if limit, ok := arguments["limit"].(float64); ok && limit > 0 {
+		resourceListOptions.Limit = int64(limit)
+	}
+	if c, ok := arguments["continue"].(string); ok {
+		resourceListOptions.Continue = c
+	}
+	// Projections are evaluated against the complete objects, the table representation can't be used
+	if p, _ := parseProjection(arguments); p != nil {
+		resourceListOptions.AsTable = false
+	}
+	return resourceListOptions, nil
+}
+
+// printList prints the list with the configured list output (or the projection if provided),
+// prepending the continue token if the list is paginated
+func (s *Server) printList(ret runtime.Unstructured, p *projection) (string, error) {
+	var printed string
+	var err error
+	if p != nil {
+		printed, err = p.print(ret)
+	} else {
+		printed, err = s.configuration.ListOutput.PrintObj(ret)
+	}
+	if err != nil {
+		return printed, err
+	}
+	continueToken, _, _ := unstructured.NestedString(ret.UnstructuredContent(), "metadata", "continue")
+	if continueToken == "" {
+		return printed, nil
+	}
+	remaining := ""
+	if remainingItemCount, found, _ := unstructured.NestedInt64(ret.UnstructuredContent(), "metadata", "remainingItemCount"); found {
+		remaining = fmt.Sprintf(" (%d remaining)", remainingItemCount)
+	}
+	return fmt.Sprintf("# The list is paginated, more items are available%s. "+
+		"Call the tool again with the same arguments and continue set to the following token to retrieve the next page\n"+
+		"# continue: %s\n%s", remaining, continueToken, printed), nil
+}
+
+// projection of the objects to print only the requested values (jsonpath or fields arguments) instead of the complete objects
+type projection struct {
+	jsonPath string
+	fields   []string
+}
+
+// parseProjection parses the jsonpath and fields arguments, returns nil if none of them is provided
+func parseProjection(arguments map[string]interface{}) (*projection, error) {
+	p := &projection{fields: stringArray(arguments["fields"])}
+	if jsonPath, ok := arguments["jsonpath"].(string); ok {
+		p.jsonPath = jsonPath
+	}
+	if p.jsonPath != "" && len(p.fields) > 0 {
+		return nil, errors.New("jsonpath and fields are mutually exclusive")
+	}
+	if p.jsonPath == "" && len(p.fields) == 0 {
+		return nil, nil
+	}
+	return p, nil
+}
+
+func (p *projection) print(obj runtime.Unstructured) (string, error) {
+	if p.jsonPath != "" {
+		return output.JSONPath(obj, p.jsonPath)
+	}
+	return output.Fields(obj, p.fields)
+}
+
+// printObject prints the object as YAML (or the projection if provided)
+func printObject(ret runtime.Unstructured, p *projection) (string, error) {
+	if p != nil {
+		return p.print(ret)
+	}
+	return output.MarshalYaml(ret)
+}
+
+// resolveGroupVersionKind resolves the GroupVersionKind from the kubectl-style resource argument (e.g. deploy, svc, deployments.apps) if provided,
+// or from the apiVersion and kind arguments otherwise
+func resolveGroupVersionKind(k *kubernetes.Kubernetes, arguments map[string]interface{}) (*schema.GroupVersionKind, error) {
+	if resource, ok := arguments["resource"].(string); ok && resource != "" {
+		return k.ResourceGroupVersionKind(resource)
+	}
+	return parseGroupVersionKind(arguments)
 }
 
 func parseGroupVersionKind(arguments map[string]interface{}) (*schema.GroupVersionKind, error) {
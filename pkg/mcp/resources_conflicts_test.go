@@ -0,0 +1,102 @@
+package mcp
+
+import (
+	"net/http"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/mark3labs/mcp-go/mcp"
+)
+
+func TestResourcesCreateOrUpdateConflicts(t *testing.T) {
+	testCase(t, func(c *mcpContext) {
+		mockServer := NewMockServer()
+		defer mockServer.Close()
+		c.withKubeConfig(mockServer.config)
+		var mu sync.Mutex
+		var force, fieldManager string
+		mockServer.Handle(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+			mu.Lock()
+			defer mu.Unlock()
+			w.Header().Set("Content-Type", "application/json")
+			switch req.URL.Path {
+			// Request Performed by DiscoveryClient to Kube API (Get API Groups legacy -core-)
+			case "/api":
+				_, _ = w.Write([]byte(`{"kind":"APIVersions","versions":[],"serverAddressByClientCIDRs":[{"clientCIDR":"0.0.0.0/0"}]}`))
+			// Request Performed by DiscoveryClient to Kube API (Get API Groups)
+			case "/apis":
+				_, _ = w.Write([]byte(`{"kind":"APIGroupList","apiVersion":"v1","groups":[` +
+					`{"name":"apps","versions":[{"groupVersion":"apps/v1","version":"v1"}],"preferredVersion":{"groupVersion":"apps/v1","version":"v1"}}` +
+					`]}`))
+			// Request Performed by DiscoveryClient to Kube API (Get API Resources)
+			case "/apis/apps/v1":
+				_, _ = w.Write([]byte(`{"kind":"APIResourceList","apiVersion":"v1","groupVersion":"apps/v1","resources":[` +
+					`{"name":"deployments","singularName":"","namespaced":true,"kind":"Deployment","verbs":["get","list","patch"]}` +
+					`]}`))
+			case "/apis/apps/v1/namespaces/default/deployments/web":
+				if req.Method != http.MethodPatch {
+					_, _ = w.Write([]byte(`{"kind":"Deployment","apiVersion":"apps/v1","metadata":{"name":"web","namespace":"default"},"spec":{"replicas":1}}`))
+					return
+				}
+				force, fieldManager = req.URL.Query().Get("force"), req.URL.Query().Get("fieldManager")
+				if force != "true" {
+					w.WriteHeader(http.StatusConflict)
+					_, _ = w.Write([]byte(`{"kind":"Status","apiVersion":"v1","status":"Failure","reason":"Conflict","code":409,` +
+						`"message":"Apply failed with 2 conflicts: conflicts with \"argocd-controller\" using apps/v1:\n- .spec.replicas\n- .spec.template.spec.containers[name=\"web\"].image",` +
+						`"details":{"name":"web","group":"apps","kind":"deployments","causes":[` +
+						`{"reason":"FieldManagerConflict","message":"conflict with \"argocd-controller\" using apps/v1","field":".spec.replicas"},` +
+						`{"reason":"FieldManagerConflict","message":"conflict with \"kubectl-edit\" using apps/v1 at 2025-01-01T00:00:00Z","field":".spec.template.spec.containers[name=\"web\"].image"}` +
+						`]}}`))
+					return
+				}
+				_, _ = w.Write([]byte(`{"kind":"Deployment","apiVersion":"apps/v1","metadata":{"name":"web","namespace":"default"},"spec":{"replicas":3}}`))
+			}
+		}))
+		deployment := "apiVersion: apps/v1\nkind: Deployment\nmetadata:\n  name: web\n  namespace: default\nspec:\n  replicas: 3\n"
+		t.Run("resources_create_or_update with conflicts returns structured conflict report", func(t *testing.T) {
+			toolResult, _ := c.callTool("resources_create_or_update", map[string]interface{}{"resource": deployment})
+			if !toolResult.IsError {
+				t.Fatalf("call tool should fail")
+			}
+			expected := "failed to create or update resources, the following fields are owned by other field managers (YAML). " +
+				"Either update the resource to match the values set by the other managers, or retry with force to take ownership of the fields\n" +
+				"apiVersion: apps/v1\n" +
+				"conflicts:\n" +
+				"- field: .spec.replicas\n" +
+				"  manager: argocd-controller\n" +
+				"  message: conflict with \"argocd-controller\" using apps/v1\n" +
+				"- field: .spec.template.spec.containers[name=\"web\"].image\n" +
+				"  manager: kubectl-edit\n" +
+				"  message: conflict with \"kubectl-edit\" using apps/v1 at 2025-01-01T00:00:00Z\n" +
+				"kind: Deployment\n" +
+				"name: web\n" +
+				"namespace: default\n"
+			if toolResult.Content[0].(mcp.TextContent).Text != expected {
+				t.Errorf("unexpected conflict report %v", toolResult.Content[0].(mcp.TextContent).Text)
+			}
+		})
+		t.Run("resources_create_or_update with diff and conflicts returns structured conflict report", func(t *testing.T) {
+			toolResult, _ := c.callTool("resources_create_or_update", map[string]interface{}{"resource": deployment, "diff": true})
+			if !toolResult.IsError {
+				t.Fatalf("call tool should fail")
+			}
+			expected := "failed to diff resources, the following fields are owned by other field managers (YAML). "
+			if !strings.HasPrefix(toolResult.Content[0].(mcp.TextContent).Text, expected) {
+				t.Errorf("unexpected conflict report %v", toolResult.Content[0].(mcp.TextContent).Text)
+			}
+		})
+		t.Run("resources_create_or_update with force and fieldManager takes ownership", func(t *testing.T) {
+			toolResult, err := c.callTool("resources_create_or_update", map[string]interface{}{"resource": deployment, "force": true, "fieldManager": "an-agent"})
+			if err != nil || toolResult.IsError {
+				t.Fatalf("call tool failed %v %v", err, toolResult.Content)
+			}
+			if force != "true" {
+				t.Errorf("expected forced apply, got force=%v", force)
+			}
+			if fieldManager != "an-agent" {
+				t.Errorf("expected field manager an-agent, got %v", fieldManager)
+			}
+		})
+	})
+}
@@ -0,0 +1,205 @@
+package mcp
+
+import (
+	"encoding/json"
+	"net/http"
+	"regexp"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+func TestResourcesDeleteOptions(t *testing.T) {
+	testCase(t, func(c *mcpContext) {
+		mockServer := NewMockServer()
+		defer mockServer.Close()
+		c.withKubeConfig(mockServer.config)
+		var mu sync.Mutex
+		deleted := map[string]metav1.DeleteOptions{}
+		cm2UID := types.UID("uid-cm-2")
+		mockServer.Handle(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+			mu.Lock()
+			defer mu.Unlock()
+			w.Header().Set("Content-Type", "application/json")
+			switch req.URL.Path {
+			// Request Performed by DiscoveryClient to Kube API (Get API Groups legacy -core-)
+			case "/api":
+				_, _ = w.Write([]byte(`{"kind":"APIVersions","versions":["v1"],"serverAddressByClientCIDRs":[{"clientCIDR":"0.0.0.0/0"}]}`))
+			// Request Performed by DiscoveryClient to Kube API (Get API Groups)
+			case "/apis":
+				_, _ = w.Write([]byte(`{"kind":"APIGroupList","apiVersion":"v1","groups":[]}`))
+			// Request Performed by DiscoveryClient to Kube API (Get API Resources)
+			case "/api/v1":
+				_, _ = w.Write([]byte(`{"kind":"APIResourceList","apiVersion":"v1","groupVersion":"v1","resources":[` +
+					`{"name":"configmaps","singularName":"","namespaced":true,"kind":"ConfigMap","verbs":["delete","get","list"]}` +
+					`]}`))
+			case "/api/v1/namespaces/test-ns/configmaps":
+				list := &v1.ConfigMapList{TypeMeta: metav1.TypeMeta{APIVersion: "v1", Kind: "ConfigMapList"}}
+				if req.URL.Query().Get("labelSelector") == "app=test" {
+					list.Items = []v1.ConfigMap{
+						{ObjectMeta: metav1.ObjectMeta{Namespace: "test-ns", Name: "cm-2", UID: cm2UID}},
+						{ObjectMeta: metav1.ObjectMeta{Namespace: "test-ns", Name: "cm-1", UID: "uid-cm-1"}},
+					}
+				}
+				writeObject(w, list)
+			default:
+				if req.Method == http.MethodDelete && strings.HasPrefix(req.URL.Path, "/api/v1/namespaces/test-ns/configmaps/") {
+					deleteOptions := metav1.DeleteOptions{}
+					_ = json.NewDecoder(req.Body).Decode(&deleteOptions)
+					deleted[strings.TrimPrefix(req.URL.Path, "/api/v1/namespaces/test-ns/configmaps/")] = deleteOptions
+					_, _ = w.Write([]byte(`{"kind":"Status","apiVersion":"v1","status":"Success"}`))
+				}
+			}
+		}))
+		reset := func() {
+			mu.Lock()
+			defer mu.Unlock()
+			deleted = map[string]metav1.DeleteOptions{}
+		}
+		confirmationToken := regexp.MustCompile(`confirmationToken set to ([0-9a-f]+) to delete them`)
+		preview := func(t *testing.T) string {
+			toolResult, err := c.callTool("resources_delete", map[string]interface{}{
+				"apiVersion": "v1", "kind": "ConfigMap", "namespace": "test-ns", "labelSelector": "app=test",
+			})
+			if err != nil || toolResult.IsError {
+				t.Fatalf("call tool failed %v %v", err, toolResult.Content)
+			}
+			matches := confirmationToken.FindStringSubmatch(toolResult.Content[0].(mcp.TextContent).Text)
+			if matches == nil {
+				t.Fatalf("expected confirmation token, got %v", toolResult.Content[0].(mcp.TextContent).Text)
+			}
+			return matches[1]
+		}
+		t.Run("resources_delete with name and selector returns error", func(t *testing.T) {
+			toolResult, _ := c.callTool("resources_delete", map[string]interface{}{
+				"apiVersion": "v1", "kind": "ConfigMap", "namespace": "test-ns", "name": "cm-1", "labelSelector": "app=test",
+			})
+			if !toolResult.IsError {
+				t.Fatalf("call tool should fail")
+			}
+			if toolResult.Content[0].(mcp.TextContent).Text != "failed to delete resource, name and labelSelector or fieldSelector are mutually exclusive" {
+				t.Fatalf("invalid error message, got %v", toolResult.Content[0].(mcp.TextContent).Text)
+			}
+		})
+		t.Run("resources_delete with invalid propagationPolicy returns error", func(t *testing.T) {
+			toolResult, _ := c.callTool("resources_delete", map[string]interface{}{
+				"apiVersion": "v1", "kind": "ConfigMap", "namespace": "test-ns", "name": "cm-1", "propagationPolicy": "Cascade",
+			})
+			if !toolResult.IsError {
+				t.Fatalf("call tool should fail")
+			}
+			expected := `failed to delete resource: invalid propagation policy "Cascade", must be one of: Foreground, Background, Orphan`
+			if toolResult.Content[0].(mcp.TextContent).Text != expected {
+				t.Fatalf("invalid error message, got %v", toolResult.Content[0].(mcp.TextContent).Text)
+			}
+		})
+		t.Run("resources_delete with options sends delete options", func(t *testing.T) {
+			reset()
+			toolResult, err := c.callTool("resources_delete", map[string]interface{}{
+				"apiVersion": "v1", "kind": "ConfigMap", "namespace": "test-ns", "name": "cm-1",
+				"propagationPolicy": "Foreground", "gracePeriodSeconds": 0, "dryRun": true,
+			})
+			if err != nil || toolResult.IsError {
+				t.Fatalf("call tool failed %v %v", err, toolResult.Content)
+			}
+			if toolResult.Content[0].(mcp.TextContent).Text != "Resource would be deleted (server dry-run, nothing was deleted)" {
+				t.Errorf("unexpected result %v", toolResult.Content[0].(mcp.TextContent).Text)
+			}
+			deleteOptions, ok := deleted["cm-1"]
+			if !ok {
+				t.Fatalf("expected cm-1 delete request")
+			}
+			if deleteOptions.PropagationPolicy == nil || *deleteOptions.PropagationPolicy != metav1.DeletePropagationForeground {
+				t.Errorf("unexpected propagation policy %v", deleteOptions.PropagationPolicy)
+			}
+			if deleteOptions.GracePeriodSeconds == nil || *deleteOptions.GracePeriodSeconds != 0 {
+				t.Errorf("unexpected grace period %v", deleteOptions.GracePeriodSeconds)
+			}
+			if len(deleteOptions.DryRun) != 1 || deleteOptions.DryRun[0] != metav1.DryRunAll {
+				t.Errorf("unexpected dry run %v", deleteOptions.DryRun)
+			}
+		})
+		t.Run("resources_delete with selector without confirmationToken lists matching resources", func(t *testing.T) {
+			reset()
+			toolResult, err := c.callTool("resources_delete", map[string]interface{}{
+				"apiVersion": "v1", "kind": "ConfigMap", "namespace": "test-ns", "labelSelector": "app=test",
+			})
+			if err != nil || toolResult.IsError {
+				t.Fatalf("call tool failed %v %v", err, toolResult.Content)
+			}
+			expected := regexp.MustCompile("^# The following 2 ConfigMap resources \\(YAML\\) match the selector and would be deleted. " +
+				"Review them and call resources_delete again with the same arguments and confirmationToken set to [0-9a-f]{16} to delete them\n" +
+				"- cm-1\n- cm-2\n$")
+			if !expected.MatchString(toolResult.Content[0].(mcp.TextContent).Text) {
+				t.Errorf("unexpected result %v", toolResult.Content[0].(mcp.TextContent).Text)
+			}
+			if len(deleted) != 0 {
+				t.Errorf("no resources should be deleted without confirmation, got %v", deleted)
+			}
+		})
+		t.Run("resources_delete with selector and confirmationToken deletes reviewed resources", func(t *testing.T) {
+			reset()
+			token := preview(t)
+			toolResult, err := c.callTool("resources_delete", map[string]interface{}{
+				"apiVersion": "v1", "kind": "ConfigMap", "namespace": "test-ns", "labelSelector": "app=test", "confirmationToken": token, "propagationPolicy": "background",
+			})
+			if err != nil || toolResult.IsError {
+				t.Fatalf("call tool failed %v %v", err, toolResult.Content)
+			}
+			if toolResult.Content[0].(mcp.TextContent).Text != "# The following resources (YAML) have been deleted successfully\n- cm-1\n- cm-2\n" {
+				t.Errorf("unexpected result %v", toolResult.Content[0].(mcp.TextContent).Text)
+			}
+			if len(deleted) != 2 {
+				t.Fatalf("expected 2 resources deleted, got %v", deleted)
+			}
+			if policy := deleted["cm-2"].PropagationPolicy; policy == nil || *policy != metav1.DeletePropagationBackground {
+				t.Errorf("unexpected propagation policy %v", policy)
+			}
+			if preconditions := deleted["cm-2"].Preconditions; preconditions == nil || preconditions.UID == nil || *preconditions.UID != "uid-cm-2" {
+				t.Errorf("unexpected preconditions %v", preconditions)
+			}
+		})
+		t.Run("resources_delete with selector and stale confirmationToken deletes nothing", func(t *testing.T) {
+			reset()
+			token := preview(t)
+			mu.Lock()
+			cm2UID = "uid-cm-2-recreated"
+			mu.Unlock()
+			toolResult, _ := c.callTool("resources_delete", map[string]interface{}{
+				"apiVersion": "v1", "kind": "ConfigMap", "namespace": "test-ns", "labelSelector": "app=test", "confirmationToken": token,
+			})
+			if !toolResult.IsError {
+				t.Fatalf("call tool should fail")
+			}
+			expected := regexp.MustCompile("^failed to delete resources: the resources matching the selector changed since they were reviewed \\(confirmation token mismatch\\), nothing was deleted\n" +
+				"# The following 2 ConfigMap resources \\(YAML\\) match the selector now. " +
+				"Review them and call resources_delete again with the same arguments and confirmationToken set to [0-9a-f]{16} to delete them\n" +
+				"- cm-1\n- cm-2\n$")
+			if !expected.MatchString(toolResult.Content[0].(mcp.TextContent).Text) {
+				t.Errorf("unexpected result %v", toolResult.Content[0].(mcp.TextContent).Text)
+			}
+			if strings.Contains(toolResult.Content[0].(mcp.TextContent).Text, token) {
+				t.Errorf("expected a new confirmation token, got %v", toolResult.Content[0].(mcp.TextContent).Text)
+			}
+			if len(deleted) != 0 {
+				t.Errorf("no resources should be deleted with a stale confirmation token, got %v", deleted)
+			}
+		})
+		t.Run("resources_delete with selector matching no resources", func(t *testing.T) {
+			toolResult, err := c.callTool("resources_delete", map[string]interface{}{
+				"apiVersion": "v1", "kind": "ConfigMap", "namespace": "test-ns", "labelSelector": "app=other", "confirmationToken": "0123456789abcdef",
+			})
+			if err != nil || toolResult.IsError {
+				t.Fatalf("call tool failed %v %v", err, toolResult.Content)
+			}
+			if toolResult.Content[0].(mcp.TextContent).Text != "No resources match the selector, nothing was deleted" {
+				t.Errorf("unexpected result %v", toolResult.Content[0].(mcp.TextContent).Text)
+			}
+		})
+	})
+}
@@ -0,0 +1,138 @@
+package mcp
+
+import (
+	"net/http"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"sigs.k8s.io/yaml"
+)
+
+func TestResourcesCreateOrUpdateDryRun(t *testing.T) {
+	testCase(t, func(c *mcpContext) {
+		mockServer := NewMockServer()
+		defer mockServer.Close()
+		c.withKubeConfig(mockServer.config)
+		var mu sync.Mutex
+		var applied []string
+		unchanged := false
+		mockServer.Handle(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+			mu.Lock()
+			defer mu.Unlock()
+			w.Header().Set("Content-Type", "application/json")
+			switch req.URL.Path {
+			// Request Performed by DiscoveryClient to Kube API (Get API Groups legacy -core-)
+			case "/api":
+				_, _ = w.Write([]byte(`{"kind":"APIVersions","versions":["v1"],"serverAddressByClientCIDRs":[{"clientCIDR":"0.0.0.0/0"}]}`))
+			// Request Performed by DiscoveryClient to Kube API (Get API Groups)
+			case "/apis":
+				_, _ = w.Write([]byte(`{"kind":"APIGroupList","apiVersion":"v1","groups":[]}`))
+			// Request Performed by DiscoveryClient to Kube API (Get API Resources)
+			case "/api/v1":
+				_, _ = w.Write([]byte(`{"kind":"APIResourceList","apiVersion":"v1","groupVersion":"v1","resources":[` +
+					`{"name":"configmaps","singularName":"","namespaced":true,"kind":"ConfigMap","verbs":["create","get","list","patch"]}` +
+					`]}`))
+			case "/api/v1/namespaces/default/configmaps/existing-cm":
+				if req.Method == http.MethodPatch {
+					applied = append(applied, req.URL.Query().Get("dryRun"))
+					_, _ = w.Write([]byte(`{"kind":"ConfigMap","apiVersion":"v1","metadata":{"name":"existing-cm","namespace":"default","uid":"1234",` +
+						`"resourceVersion":"2","creationTimestamp":"2025-01-01T00:00:00Z",` +
+						`"managedFields":[{"manager":"kubernetes-mcp-server","operation":"Apply"}]},"data":{"key":"new-value","other":"value"}}`))
+					return
+				}
+				_, _ = w.Write([]byte(`{"kind":"ConfigMap","apiVersion":"v1","metadata":{"name":"existing-cm","namespace":"default","uid":"1234",` +
+					`"resourceVersion":"1","creationTimestamp":"2025-01-01T00:00:00Z",` +
+					`"managedFields":[{"manager":"kubectl","operation":"Update"}]},"data":{"key":"value","other":"value"}}`))
+			case "/api/v1/namespaces/default/configmaps/new-cm":
+				if unchanged {
+					_, _ = w.Write([]byte(`{"kind":"ConfigMap","apiVersion":"v1","metadata":{"name":"new-cm","namespace":"default","resourceVersion":"3"}}`))
+					return
+				}
+				if req.Method == http.MethodPatch {
+					applied = append(applied, req.URL.Query().Get("dryRun"))
+					_, _ = w.Write([]byte(`{"kind":"ConfigMap","apiVersion":"v1","metadata":{"name":"new-cm","namespace":"default","uid":"5678",` +
+						`"resourceVersion":"1","creationTimestamp":"2025-01-01T00:00:00Z"},"data":{"key":"value"}}`))
+					return
+				}
+				w.WriteHeader(http.StatusNotFound)
+				_, _ = w.Write([]byte(`{"kind":"Status","apiVersion":"v1","status":"Failure","message":"configmaps \"new-cm\" not found","reason":"NotFound","code":404}`))
+			}
+		}))
+		existingCm := "apiVersion: v1\nkind: ConfigMap\nmetadata:\n  name: existing-cm\n  namespace: default\ndata:\n  key: new-value\n"
+		newCm := "apiVersion: v1\nkind: ConfigMap\nmetadata:\n  name: new-cm\n  namespace: default\ndata:\n  key: value\n"
+		t.Run("resources_create_or_update with dryRun performs server dry-run", func(t *testing.T) {
+			applied = nil
+			toolResult, err := c.callTool("resources_create_or_update", map[string]interface{}{"resource": existingCm, "dryRun": true})
+			if err != nil || toolResult.IsError {
+				t.Fatalf("call tool failed %v %v", err, toolResult.Content)
+			}
+			if len(applied) != 1 || applied[0] != "All" {
+				t.Errorf("expected a single dry-run apply, got %v", applied)
+			}
+			text := toolResult.Content[0].(mcp.TextContent).Text
+			if !strings.HasPrefix(text, "# The following resources (YAML) would be created or updated (server dry-run, nothing was persisted)\n") {
+				t.Errorf("unexpected result %v", text)
+			}
+			var decoded []unstructured.Unstructured
+			if err = yaml.Unmarshal([]byte(text), &decoded); err != nil || len(decoded) != 1 {
+				t.Fatalf("invalid tool result content %v", err)
+			}
+			if decoded[0].Object["data"].(map[string]interface{})["key"] != "new-value" {
+				t.Errorf("unexpected dry-run result %v", decoded[0].Object)
+			}
+		})
+		t.Run("resources_create_or_update with diff returns unified diff of existing resource", func(t *testing.T) {
+			applied = nil
+			toolResult, err := c.callTool("resources_create_or_update", map[string]interface{}{"resource": existingCm, "diff": true})
+			if err != nil || toolResult.IsError {
+				t.Fatalf("call tool failed %v %v", err, toolResult.Content)
+			}
+			if len(applied) != 1 || applied[0] != "All" {
+				t.Errorf("expected a single dry-run apply, got %v", applied)
+			}
+			expected := "# Unified diff between the live resources and the result of the server dry-run (nothing was persisted)\n" +
+				"--- live/v1.ConfigMap.default.existing-cm\n" +
+				"+++ merged/v1.ConfigMap.default.existing-cm\n" +
+				"@@ -1,6 +1,6 @@\n" +
+				" apiVersion: v1\n" +
+				" data:\n" +
+				"-  key: value\n" +
+				"+  key: new-value\n" +
+				"   other: value\n" +
+				" kind: ConfigMap\n" +
+				" metadata:\n"
+			if toolResult.Content[0].(mcp.TextContent).Text != expected {
+				t.Errorf("unexpected diff %v", toolResult.Content[0].(mcp.TextContent).Text)
+			}
+		})
+		t.Run("resources_create_or_update with diff returns unified diff of new resource", func(t *testing.T) {
+			toolResult, err := c.callTool("resources_create_or_update", map[string]interface{}{"resource": newCm, "diff": true})
+			if err != nil || toolResult.IsError {
+				t.Fatalf("call tool failed %v %v", err, toolResult.Content)
+			}
+			text := toolResult.Content[0].(mcp.TextContent).Text
+			if !strings.Contains(text, "--- live/v1.ConfigMap.default.new-cm\n+++ merged/v1.ConfigMap.default.new-cm\n@@ -0,0 +1,7 @@\n+apiVersion: v1\n") {
+				t.Errorf("unexpected diff %v", text)
+			}
+			if strings.Contains(text, "uid") || strings.Contains(text, "resourceVersion") || strings.Contains(text, "creationTimestamp") {
+				t.Errorf("diff should not include server managed fields %v", text)
+			}
+		})
+		t.Run("resources_create_or_update with diff and no changes", func(t *testing.T) {
+			unchangedCm := "apiVersion: v1\nkind: ConfigMap\nmetadata:\n  name: new-cm\n  namespace: default\n"
+			mu.Lock()
+			unchanged = true
+			mu.Unlock()
+			toolResult, err := c.callTool("resources_create_or_update", map[string]interface{}{"resource": unchangedCm, "diff": true})
+			if err != nil || toolResult.IsError {
+				t.Fatalf("call tool failed %v %v", err, toolResult.Content)
+			}
+			if toolResult.Content[0].(mcp.TextContent).Text != "# No differences between the live resources and the result of the server dry-run (nothing was persisted)\n" {
+				t.Errorf("unexpected result %v", toolResult.Content[0].(mcp.TextContent).Text)
+			}
+		})
+	})
+}
@@ -0,0 +1,153 @@
+package mcp
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"sigs.k8s.io/yaml"
+
+	"github.com/manusa/kubernetes-mcp-server/pkg/config"
+	"github.com/manusa/kubernetes-mcp-server/pkg/kubernetes"
+)
+
+func TestResourcesExplain(t *testing.T) {
+	deniedResourcesServer := &config.StaticConfig{DeniedResources: []config.GroupVersionKind{{Version: "v1", Kind: "Secret"}}}
+	testCaseWithContext(t, &mcpContext{staticConfig: deniedResourcesServer}, func(c *mcpContext) {
+		mockServer := NewMockServer()
+		defer mockServer.Close()
+		c.withKubeConfig(mockServer.config)
+		mockServer.Handle(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+			w.Header().Set("Content-Type", "application/json")
+			switch req.URL.Path {
+			// Request Performed by DiscoveryClient to Kube API (Get API Groups legacy -core-)
+			case "/api":
+				_, _ = w.Write([]byte(`{"kind":"APIVersions","versions":["v1"],"serverAddressByClientCIDRs":[{"clientCIDR":"0.0.0.0/0"}]}`))
+			// Request Performed by DiscoveryClient to Kube API (Get API Groups)
+			case "/apis":
+				_, _ = w.Write([]byte(`{"kind":"APIGroupList","apiVersion":"v1","groups":[` +
+					`{"name":"apps","versions":[{"groupVersion":"apps/v1","version":"v1"}],"preferredVersion":{"groupVersion":"apps/v1","version":"v1"}}` +
+					`]}`))
+			// Request Performed by DiscoveryClient to Kube API (Get API Resources)
+			case "/api/v1":
+				_, _ = w.Write([]byte(`{"kind":"APIResourceList","apiVersion":"v1","groupVersion":"v1","resources":[` +
+					`{"name":"secrets","singularName":"secret","namespaced":true,"kind":"Secret","verbs":["get","list"]}` +
+					`]}`))
+			case "/apis/apps/v1":
+				_, _ = w.Write([]byte(`{"kind":"APIResourceList","apiVersion":"v1","groupVersion":"apps/v1","resources":[` +
+					`{"name":"deployments","singularName":"deployment","namespaced":true,"kind":"Deployment","verbs":["get","list"],"shortNames":["deploy"]}` +
+					`]}`))
+			// Request Performed by DiscoveryClient to Kube API (Get OpenAPI v3 paths)
+			case "/openapi/v3":
+				_, _ = w.Write([]byte(`{"paths":{` +
+					`"api/v1":{"serverRelativeURL":"/openapi/v3/api/v1?hash=CORE"},` +
+					`"apis/apps/v1":{"serverRelativeURL":"/openapi/v3/apis/apps/v1?hash=APPS"}` +
+					`}}`))
+			case "/openapi/v3/apis/apps/v1":
+				_, _ = w.Write([]byte(`{"openapi":"3.0.0","info":{"title":"Kubernetes","version":"v1.33.0"},"paths":{},"components":{"schemas":{` +
+					`"io.k8s.api.apps.v1.Deployment":{"description":"Deployment enables declarative updates for Pods and ReplicaSets.","type":"object",` +
+					`"properties":{"apiVersion":{"description":"APIVersion defines the versioned schema.","type":"string"},` +
+					`"metadata":{"allOf":[{"$ref":"#/components/schemas/io.k8s.apimachinery.pkg.apis.meta.v1.ObjectMeta"}],"default":{},"description":"Standard object's metadata."},` +
+					`"spec":{"allOf":[{"$ref":"#/components/schemas/io.k8s.api.apps.v1.DeploymentSpec"}],"default":{},"description":"Specification of the desired behavior of the Deployment."}},` +
+					`"x-kubernetes-group-version-kind":[{"group":"apps","kind":"Deployment","version":"v1"}]},` +
+					`"io.k8s.api.apps.v1.DeploymentSpec":{"description":"DeploymentSpec is the specification of the desired behavior of the Deployment.","type":"object","required":["template"],` +
+					`"properties":{"replicas":{"description":"Number of desired pods.","type":"integer","format":"int32"},` +
+					`"template":{"allOf":[{"$ref":"#/components/schemas/io.k8s.api.core.v1.PodTemplateSpec"}],"default":{},"description":"Template describes the pods that will be created."}}},` +
+					`"io.k8s.api.core.v1.PodTemplateSpec":{"description":"PodTemplateSpec describes the data a pod should have when created from a template","type":"object",` +
+					`"properties":{"spec":{"allOf":[{"$ref":"#/components/schemas/io.k8s.api.core.v1.PodSpec"}],"default":{},"description":"Specification of the desired behavior of the pod."}}},` +
+					`"io.k8s.api.core.v1.PodSpec":{"description":"PodSpec is a description of a pod.","type":"object","required":["containers"],` +
+					`"properties":{"containers":{"description":"List of containers belonging to the pod.","type":"array","items":{"allOf":[{"$ref":"#/components/schemas/io.k8s.api.core.v1.Container"}],"default":{}}},` +
+					`"nodeSelector":{"description":"NodeSelector is a selector which must be true for the pod to fit on a node.","type":"object","additionalProperties":{"type":"string","default":""}}}},` +
+					`"io.k8s.api.core.v1.Container":{"description":"A single application container that you want to run within a pod.","type":"object","required":["name"],` +
+					`"properties":{"name":{"description":"Name of the container specified as a DNS_LABEL.","type":"string","default":""},` +
+					`"livenessProbe":{"allOf":[{"$ref":"#/components/schemas/io.k8s.api.core.v1.Probe"}],"description":"Periodic probe of container liveness."}}},` +
+					`"io.k8s.api.core.v1.Probe":{"description":"Probe describes a health check to be performed against a container.","type":"object",` +
+					`"properties":{"httpGet":{"allOf":[{"$ref":"#/components/schemas/io.k8s.api.core.v1.HTTPGetAction"}],"description":"HTTPGet specifies an HTTP GET request to perform."},` +
+					`"periodSeconds":{"description":"How often (in seconds) to perform the probe.","type":"integer","format":"int32"}}},` +
+					`"io.k8s.api.core.v1.HTTPGetAction":{"description":"HTTPGetAction describes an action based on HTTP Get requests.","type":"object","required":["port"],` +
+					`"properties":{"port":{"allOf":[{"$ref":"#/components/schemas/io.k8s.apimachinery.pkg.util.intstr.IntOrString"}],"description":"Name or number of the port to access on the container."}}},` +
+					`"io.k8s.apimachinery.pkg.util.intstr.IntOrString":{"description":"IntOrString is a type that can hold an int32 or a string.","type":"string","format":"int-or-string"},` +
+					`"io.k8s.apimachinery.pkg.apis.meta.v1.ObjectMeta":{"description":"ObjectMeta is metadata that all persisted resources must have.","type":"object",` +
+					`"properties":{"name":{"description":"Name must be unique within a namespace.","type":"string"}}}` +
+					`}}}`))
+			}
+		}))
+		explain := func(t *testing.T, arguments map[string]interface{}) *kubernetes.ResourcesExplainResult {
+			toolResult, err := c.callTool("resources_explain", arguments)
+			if err != nil || toolResult.IsError {
+				t.Fatalf("call tool failed %v %v", err, toolResult.Content)
+			}
+			var ret kubernetes.ResourcesExplainResult
+			if err = yaml.Unmarshal([]byte(toolResult.Content[0].(mcp.TextContent).Text), &ret); err != nil {
+				t.Fatalf("invalid tool result content %v", err)
+			}
+			return &ret
+		}
+		t.Run("resources_explain explains the resource", func(t *testing.T) {
+			ret := explain(t, map[string]interface{}{"apiVersion": "apps/v1", "kind": "Deployment"})
+			if ret.APIVersion != "apps/v1" || ret.Kind != "Deployment" || ret.Type != "object" {
+				t.Errorf("unexpected result %v", ret)
+			}
+			if ret.Description != "Deployment enables declarative updates for Pods and ReplicaSets." {
+				t.Errorf("unexpected description %s", ret.Description)
+			}
+			if len(ret.Fields) != 3 || ret.Fields[0].Name != "apiVersion" || ret.Fields[1].Name != "metadata" || ret.Fields[2].Name != "spec" {
+				t.Fatalf("unexpected fields %v", ret.Fields)
+			}
+			if ret.Fields[1].Type != "ObjectMeta" || ret.Fields[1].Description != "Standard object's metadata." {
+				t.Errorf("unexpected metadata field %v", ret.Fields[1])
+			}
+		})
+		t.Run("resources_explain explains a nested field", func(t *testing.T) {
+			ret := explain(t, map[string]interface{}{"apiVersion": "apps/v1", "kind": "Deployment", "field": "spec.template.spec.containers.livenessProbe"})
+			if ret.Field != "spec.template.spec.containers.livenessProbe" || ret.Type != "Probe" || ret.Required {
+				t.Errorf("unexpected result %v", ret)
+			}
+			if ret.Description != "Periodic probe of container liveness." {
+				t.Errorf("unexpected description %s", ret.Description)
+			}
+			if len(ret.Fields) != 2 || ret.Fields[0].Name != "httpGet" || ret.Fields[0].Type != "HTTPGetAction" ||
+				ret.Fields[1].Name != "periodSeconds" || ret.Fields[1].Type != "integer" {
+				t.Errorf("unexpected fields %v", ret.Fields)
+			}
+		})
+		t.Run("resources_explain explains array, map and int-or-string fields", func(t *testing.T) {
+			ret := explain(t, map[string]interface{}{"apiVersion": "apps/v1", "kind": "Deployment", "field": "spec.template.spec"})
+			if len(ret.Fields) != 2 || ret.Fields[0].Type != "[]Container" || !ret.Fields[0].Required ||
+				ret.Fields[1].Type != "map[string]string" || ret.Fields[1].Required {
+				t.Errorf("unexpected fields %v", ret.Fields)
+			}
+			ret = explain(t, map[string]interface{}{"apiVersion": "apps/v1", "kind": "Deployment", "field": "spec.template.spec.containers.livenessProbe.httpGet.port"})
+			if ret.Type != "IntOrString" || !ret.Required {
+				t.Errorf("unexpected result %v", ret)
+			}
+		})
+		t.Run("resources_explain with kubectl explain style resource", func(t *testing.T) {
+			ret := explain(t, map[string]interface{}{"resource": "deploy.spec.template.spec.containers"})
+			if ret.Kind != "Deployment" || ret.Field != "spec.template.spec.containers" || ret.Type != "[]Container" || !ret.Required {
+				t.Errorf("unexpected result %v", ret)
+			}
+			if len(ret.Fields) != 2 || ret.Fields[0].Name != "livenessProbe" || ret.Fields[1].Name != "name" || !ret.Fields[1].Required {
+				t.Errorf("unexpected fields %v", ret.Fields)
+			}
+		})
+		t.Run("resources_explain with missing field returns error", func(t *testing.T) {
+			toolResult, _ := c.callTool("resources_explain", map[string]interface{}{"apiVersion": "apps/v1", "kind": "Deployment", "field": "spec.template.nope.other"})
+			if !toolResult.IsError {
+				t.Fatalf("call tool should fail")
+			}
+			if toolResult.Content[0].(mcp.TextContent).Text != `failed to explain resource: field "spec.template.nope" does not exist in Deployment` {
+				t.Errorf("invalid error message, got %v", toolResult.Content[0].(mcp.TextContent).Text)
+			}
+		})
+		t.Run("resources_explain with denied resource returns error", func(t *testing.T) {
+			toolResult, _ := c.callTool("resources_explain", map[string]interface{}{"apiVersion": "v1", "kind": "Secret"})
+			if !toolResult.IsError {
+				t.Fatalf("call tool should fail")
+			}
+			if toolResult.Content[0].(mcp.TextContent).Text != "failed to explain resource: resource not allowed: /v1, Kind=Secret" {
+				t.Errorf("invalid error message, got %v", toolResult.Content[0].(mcp.TextContent).Text)
+			}
+		})
+	})
+}
@@ -0,0 +1,118 @@
+package mcp
+
+import (
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/mark3labs/mcp-go/mcp"
+
+	"github.com/manusa/kubernetes-mcp-server/pkg/output"
+)
+
+func TestListPagination(t *testing.T) {
+	var mu sync.Mutex
+	var query url.Values
+	handler := http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		mu.Lock()
+		defer mu.Unlock()
+		w.Header().Set("Content-Type", "application/json")
+		switch req.URL.Path {
+		// Request Performed by DiscoveryClient to Kube API (Get API Groups legacy -core-)
+		case "/api":
+			_, _ = w.Write([]byte(`{"kind":"APIVersions","versions":["v1"],"serverAddressByClientCIDRs":[{"clientCIDR":"0.0.0.0/0"}]}`))
+		// Request Performed by DiscoveryClient to Kube API (Get API Groups)
+		case "/apis":
+			_, _ = w.Write([]byte(`{"kind":"APIGroupList","apiVersion":"v1","groups":[]}`))
+		// Request Performed by DiscoveryClient to Kube API (Get API Resources)
+		case "/api/v1":
+			_, _ = w.Write([]byte(`{"kind":"APIResourceList","apiVersion":"v1","groupVersion":"v1","resources":[` +
+				`{"name":"pods","singularName":"pod","namespaced":true,"kind":"Pod","verbs":["get","list"]},` +
+				`{"name":"namespaces","singularName":"namespace","namespaced":false,"kind":"Namespace","verbs":["get","list"]}` +
+				`]}`))
+		// Request Performed by the access checks (SelfSubjectAccessReview)
+		case "/apis/authorization.k8s.io/v1/selfsubjectaccessreviews":
+			_, _ = w.Write([]byte(`{"kind":"SelfSubjectAccessReview","apiVersion":"authorization.k8s.io/v1","status":{"allowed":true}}`))
+		case "/api/v1/pods", "/api/v1/namespaces/ns-1/pods", "/api/v1/namespaces":
+			query = req.URL.Query()
+			if strings.HasPrefix(req.Header.Get("Accept"), "application/json;as=Table") {
+				_, _ = w.Write([]byte(`{"kind":"Table","apiVersion":"meta.k8s.io/v1","metadata":{"continue":"TOKEN-2","remainingItemCount":41},` +
+					`"columnDefinitions":[{"name":"Name","type":"string"}],"rows":[{"cells":["item-1"],"object":{"kind":"PartialObjectMetadata","apiVersion":"meta.k8s.io/v1","metadata":{"name":"item-1"}}}]}`))
+				return
+			}
+			if query.Get("continue") == "TOKEN-1" {
+				_, _ = w.Write([]byte(`{"kind":"List","apiVersion":"v1","metadata":{},"items":[{"apiVersion":"v1","kind":"Pod","metadata":{"name":"item-2"}}]}`))
+				return
+			}
+			_, _ = w.Write([]byte(`{"kind":"List","apiVersion":"v1","metadata":{"continue":"TOKEN-1","remainingItemCount":1},"items":[{"apiVersion":"v1","kind":"Pod","metadata":{"name":"item-1"}}]}`))
+		}
+	})
+	lastQuery := func() url.Values {
+		mu.Lock()
+		defer mu.Unlock()
+		return query
+	}
+	for tool, arguments := range map[string]map[string]interface{}{
+		"resources_list":         {"apiVersion": "v1", "kind": "Pod"},
+		"pods_list":              {},
+		"pods_list_in_namespace": {"namespace": "ns-1"},
+		"namespaces_list":        {},
+	} {
+		testCase(t, func(c *mcpContext) {
+			mockServer := NewMockServer()
+			defer mockServer.Close()
+			c.withKubeConfig(mockServer.config)
+			mockServer.Handle(handler)
+			t.Run(tool+" with fieldSelector and limit returns continue token", func(t *testing.T) {
+				arguments["fieldSelector"] = "status.phase!=Running"
+				arguments["limit"] = 1
+				toolResult, err := c.callTool(tool, arguments)
+				if err != nil || toolResult.IsError {
+					t.Fatalf("call tool failed %v %v", err, toolResult.Content)
+				}
+				q := lastQuery()
+				if q.Get("fieldSelector") != "status.phase!=Running" || q.Get("limit") != "1" || q.Get("continue") != "" {
+					t.Errorf("unexpected query %v", q)
+				}
+				expected := "# The list is paginated, more items are available (1 remaining). " +
+					"Call the tool again with the same arguments and continue set to the following token to retrieve the next page\n" +
+					"# continue: TOKEN-1\n"
+				if !strings.HasPrefix(toolResult.Content[0].(mcp.TextContent).Text, expected) {
+					t.Errorf("unexpected result %v", toolResult.Content[0].(mcp.TextContent).Text)
+				}
+			})
+			t.Run(tool+" with continue returns next page", func(t *testing.T) {
+				arguments["continue"] = "TOKEN-1"
+				toolResult, err := c.callTool(tool, arguments)
+				if err != nil || toolResult.IsError {
+					t.Fatalf("call tool failed %v %v", err, toolResult.Content)
+				}
+				if q := lastQuery(); q.Get("continue") != "TOKEN-1" {
+					t.Errorf("unexpected query %v", q)
+				}
+				text := toolResult.Content[0].(mcp.TextContent).Text
+				if strings.Contains(text, "# continue:") || !strings.Contains(text, "name: item-2") {
+					t.Errorf("unexpected result %v", text)
+				}
+			})
+		})
+	}
+	testCaseWithContext(t, &mcpContext{listOutput: output.Table}, func(c *mcpContext) {
+		mockServer := NewMockServer()
+		defer mockServer.Close()
+		c.withKubeConfig(mockServer.config)
+		mockServer.Handle(handler)
+		t.Run("pods_list with table output and limit returns continue token", func(t *testing.T) {
+			toolResult, err := c.callTool("pods_list", map[string]interface{}{"limit": 1})
+			if err != nil || toolResult.IsError {
+				t.Fatalf("call tool failed %v %v", err, toolResult.Content)
+			}
+			text := toolResult.Content[0].(mcp.TextContent).Text
+			if !strings.Contains(text, "more items are available (41 remaining)") || !strings.Contains(text, "# continue: TOKEN-2\n") || !strings.Contains(text, "item-1") {
+				t.Errorf("unexpected result %v", text)
+			}
+		})
+	})
+}
@@ -0,0 +1,184 @@
+package mcp
+
+import (
+	"io"
+	"net/http"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/mark3labs/mcp-go/mcp"
+
+	"github.com/manusa/kubernetes-mcp-server/pkg/config"
+)
+
+func TestResourcesPatch(t *testing.T) {
+	testCase(t, func(c *mcpContext) {
+		mockServer := NewMockServer()
+		defer mockServer.Close()
+		c.withKubeConfig(mockServer.config)
+		var mu sync.Mutex
+		var patchPath, patchContentType, patchBody, patchFieldManager string
+		mockServer.Handle(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+			mu.Lock()
+			defer mu.Unlock()
+			w.Header().Set("Content-Type", "application/json")
+			switch req.URL.Path {
+			// Request Performed by DiscoveryClient to Kube API (Get API Groups legacy -core-)
+			case "/api":
+				_, _ = w.Write([]byte(`{"kind":"APIVersions","versions":["v1"],"serverAddressByClientCIDRs":[{"clientCIDR":"0.0.0.0/0"}]}`))
+			// Request Performed by DiscoveryClient to Kube API (Get API Groups)
+			case "/apis":
+				_, _ = w.Write([]byte(`{"kind":"APIGroupList","apiVersion":"v1","groups":[]}`))
+			// Request Performed by DiscoveryClient to Kube API (Get API Resources)
+			case "/api/v1":
+				_, _ = w.Write([]byte(`{"kind":"APIResourceList","apiVersion":"v1","groupVersion":"v1","resources":[` +
+					`{"name":"pods","singularName":"","namespaced":true,"kind":"Pod","verbs":["get","list","patch"]},` +
+					`{"name":"pods/status","singularName":"","namespaced":true,"kind":"Pod","verbs":["get","patch","update"]}` +
+					`]}`))
+			case "/api/v1/namespaces/default/pods/a-pod", "/api/v1/namespaces/default/pods/a-pod/status":
+				if req.Method != http.MethodPatch {
+					w.WriteHeader(http.StatusMethodNotAllowed)
+					return
+				}
+				body, _ := io.ReadAll(req.Body)
+				patchPath, patchContentType, patchBody = req.URL.Path, req.Header.Get("Content-Type"), string(body)
+				patchFieldManager = req.URL.Query().Get("fieldManager")
+				_, _ = w.Write([]byte(`{"kind":"Pod","apiVersion":"v1","metadata":{"name":"a-pod","namespace":"default","annotations":{"key":"value"}}}`))
+			}
+		}))
+		t.Run("resources_patch with missing name returns error", func(t *testing.T) {
+			toolResult, _ := c.callTool("resources_patch", map[string]interface{}{"apiVersion": "v1", "kind": "Pod", "patch": "{}"})
+			if !toolResult.IsError {
+				t.Fatalf("call tool should fail")
+			}
+			if toolResult.Content[0].(mcp.TextContent).Text != "failed to patch resource, missing argument name" {
+				t.Fatalf("invalid error message, got %v", toolResult.Content[0].(mcp.TextContent).Text)
+			}
+		})
+		t.Run("resources_patch with missing patch returns error", func(t *testing.T) {
+			toolResult, _ := c.callTool("resources_patch", map[string]interface{}{"apiVersion": "v1", "kind": "Pod", "name": "a-pod"})
+			if !toolResult.IsError {
+				t.Fatalf("call tool should fail")
+			}
+			if toolResult.Content[0].(mcp.TextContent).Text != "failed to patch resource, missing argument patch" {
+				t.Fatalf("invalid error message, got %v", toolResult.Content[0].(mcp.TextContent).Text)
+			}
+		})
+		t.Run("resources_patch with invalid patchType returns error", func(t *testing.T) {
+			toolResult, _ := c.callTool("resources_patch", map[string]interface{}{"apiVersion": "v1", "kind": "Pod", "name": "a-pod", "patch": "{}", "patchType": "replace"})
+			if !toolResult.IsError {
+				t.Fatalf("call tool should fail")
+			}
+			expected := `failed to patch resource: invalid patch type "replace", must be one of: json, merge, strategic, apply`
+			if toolResult.Content[0].(mcp.TextContent).Text != expected {
+				t.Fatalf("invalid error message, got %v", toolResult.Content[0].(mcp.TextContent).Text)
+			}
+		})
+		t.Run("resources_patch defaults to merge patch", func(t *testing.T) {
+			toolResult, err := c.callTool("resources_patch", map[string]interface{}{
+				"apiVersion": "v1",
+				"kind":       "Pod",
+				"name":       "a-pod",
+				"patch":      `{"metadata":{"annotations":{"key":"value"}}}`,
+			})
+			if err != nil || toolResult.IsError {
+				t.Fatalf("call tool failed %v %v", err, toolResult.Content)
+			}
+			if patchPath != "/api/v1/namespaces/default/pods/a-pod" {
+				t.Errorf("unexpected patch path %v", patchPath)
+			}
+			if patchContentType != "application/merge-patch+json" {
+				t.Errorf("unexpected patch content type %v", patchContentType)
+			}
+			if patchBody != `{"metadata":{"annotations":{"key":"value"}}}` {
+				t.Errorf("unexpected patch body %v", patchBody)
+			}
+			if patchFieldManager != "kubernetes-mcp-server" {
+				t.Errorf("unexpected field manager %v", patchFieldManager)
+			}
+			if !strings.HasPrefix(toolResult.Content[0].(mcp.TextContent).Text, "# The following resource (YAML) has been patched successfully\n") {
+				t.Errorf("unexpected result %v", toolResult.Content[0].(mcp.TextContent).Text)
+			}
+		})
+		t.Run("resources_patch with json patch in YAML format", func(t *testing.T) {
+			toolResult, err := c.callTool("resources_patch", map[string]interface{}{
+				"apiVersion": "v1",
+				"kind":       "Pod",
+				"name":       "a-pod",
+				"patch":      "- op: remove\n  path: /metadata/finalizers\n",
+				"patchType":  "json",
+			})
+			if err != nil || toolResult.IsError {
+				t.Fatalf("call tool failed %v %v", err, toolResult.Content)
+			}
+			if patchContentType != "application/json-patch+json" {
+				t.Errorf("unexpected patch content type %v", patchContentType)
+			}
+			if patchBody != `[{"op":"remove","path":"/metadata/finalizers"}]` {
+				t.Errorf("unexpected patch body %v", patchBody)
+			}
+		})
+		t.Run("resources_patch with strategic patch on subresource", func(t *testing.T) {
+			toolResult, err := c.callTool("resources_patch", map[string]interface{}{
+				"apiVersion":  "v1",
+				"kind":        "Pod",
+				"name":        "a-pod",
+				"patch":       `{"status":{"conditions":[{"type":"Ready","status":"False"}]}}`,
+				"patchType":   "strategic",
+				"subresource": "status",
+			})
+			if err != nil || toolResult.IsError {
+				t.Fatalf("call tool failed %v %v", err, toolResult.Content)
+			}
+			if patchPath != "/api/v1/namespaces/default/pods/a-pod/status" {
+				t.Errorf("unexpected patch path %v", patchPath)
+			}
+			if patchContentType != "application/strategic-merge-patch+json" {
+				t.Errorf("unexpected patch content type %v", patchContentType)
+			}
+		})
+		t.Run("resources_patch with apply patch", func(t *testing.T) {
+			toolResult, err := c.callTool("resources_patch", map[string]interface{}{
+				"apiVersion": "v1",
+				"kind":       "Pod",
+				"name":       "a-pod",
+				"patch":      "apiVersion: v1\nkind: Pod\nmetadata:\n  name: a-pod\n  labels:\n    app: web\n",
+				"patchType":  "apply",
+			})
+			if err != nil || toolResult.IsError {
+				t.Fatalf("call tool failed %v %v", err, toolResult.Content)
+			}
+			if patchContentType != "application/apply-patch+yaml" {
+				t.Errorf("unexpected patch content type %v", patchContentType)
+			}
+			if patchFieldManager != "kubernetes-mcp-server" {
+				t.Errorf("unexpected field manager %v", patchFieldManager)
+			}
+		})
+	})
+}
+
+func TestResourcesPatchDenied(t *testing.T) {
+	deniedResourcesServer := &config.StaticConfig{DeniedResources: []config.GroupVersionKind{{Version: "v1", Kind: "Secret"}}}
+	testCaseWithContext(t, &mcpContext{staticConfig: deniedResourcesServer}, func(c *mcpContext) {
+		c.withEnvTest()
+		resourcesPatch, _ := c.callTool("resources_patch", map[string]interface{}{
+			"apiVersion": "v1",
+			"kind":       "Secret",
+			"name":       "a-secret",
+			"patch":      `{"metadata":{"annotations":{"key":"value"}}}`,
+		})
+		t.Run("resources_patch has error", func(t *testing.T) {
+			if !resourcesPatch.IsError {
+				t.Fatalf("call tool should fail")
+			}
+		})
+		t.Run("resources_patch describes denial", func(t *testing.T) {
+			expectedMessage := "failed to patch resource: resource not allowed: /v1, Kind=Secret"
+			if resourcesPatch.Content[0].(mcp.TextContent).Text != expectedMessage {
+				t.Fatalf("expected descriptive error '%s', got %v", expectedMessage, resourcesPatch.Content[0].(mcp.TextContent).Text)
+			}
+		})
+	})
+}
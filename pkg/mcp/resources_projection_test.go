@@ -0,0 +1,127 @@
+package mcp
+
+import (
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/mark3labs/mcp-go/mcp"
+
+	"github.com/manusa/kubernetes-mcp-server/pkg/output"
+)
+
+func TestResourcesProjection(t *testing.T) {
+	testCaseWithContext(t, &mcpContext{listOutput: output.Table}, func(c *mcpContext) {
+		mockServer := NewMockServer()
+		defer mockServer.Close()
+		c.withKubeConfig(mockServer.config)
+		mockServer.Handle(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+			w.Header().Set("Content-Type", "application/json")
+			switch req.URL.Path {
+			// Request Performed by DiscoveryClient to Kube API (Get API Groups legacy -core-)
+			case "/api":
+				_, _ = w.Write([]byte(`{"kind":"APIVersions","versions":["v1"],"serverAddressByClientCIDRs":[{"clientCIDR":"0.0.0.0/0"}]}`))
+			// Request Performed by DiscoveryClient to Kube API (Get API Groups)
+			case "/apis":
+				_, _ = w.Write([]byte(`{"kind":"APIGroupList","apiVersion":"v1","groups":[` +
+					`{"name":"apps","versions":[{"groupVersion":"apps/v1","version":"v1"}],"preferredVersion":{"groupVersion":"apps/v1","version":"v1"}}` +
+					`]}`))
+			// Request Performed by DiscoveryClient to Kube API (Get API Resources)
+			case "/api/v1":
+				_, _ = w.Write([]byte(`{"kind":"APIResourceList","apiVersion":"v1","groupVersion":"v1","resources":[` +
+					`{"name":"pods","singularName":"pod","namespaced":true,"kind":"Pod","verbs":["get","list"]}` +
+					`]}`))
+			case "/apis/apps/v1":
+				_, _ = w.Write([]byte(`{"kind":"APIResourceList","apiVersion":"v1","groupVersion":"apps/v1","resources":[` +
+					`{"name":"deployments","singularName":"deployment","namespaced":true,"kind":"Deployment","verbs":["get","list"]}` +
+					`]}`))
+			// Request Performed by the access checks (SelfSubjectAccessReview)
+			case "/apis/authorization.k8s.io/v1/selfsubjectaccessreviews":
+				_, _ = w.Write([]byte(`{"kind":"SelfSubjectAccessReview","apiVersion":"authorization.k8s.io/v1","status":{"allowed":true}}`))
+			case "/apis/apps/v1/namespaces/default/deployments":
+				if strings.Contains(req.Header.Get("Accept"), "as=Table") {
+					w.WriteHeader(http.StatusBadRequest)
+					return
+				}
+				_, _ = w.Write([]byte(`{"kind":"DeploymentList","apiVersion":"apps/v1","metadata":{},"items":[` +
+					`{"apiVersion":"apps/v1","kind":"Deployment","metadata":{"name":"web","namespace":"default"},"spec":{"replicas":2,"template":{"spec":{"containers":[{"name":"web","image":"nginx:1.27"}]}}}},` +
+					`{"apiVersion":"apps/v1","kind":"Deployment","metadata":{"name":"db","namespace":"default"},"spec":{"replicas":1,"template":{"spec":{"containers":[{"name":"db","image":"postgres:17"},{"name":"exporter","image":"exporter:1"}]}}}}` +
+					`]}`))
+			case "/apis/apps/v1/namespaces/default/deployments/web":
+				_, _ = w.Write([]byte(`{"apiVersion":"apps/v1","kind":"Deployment","metadata":{"name":"web","namespace":"default"},"spec":{"replicas":2}}`))
+			case "/api/v1/namespaces/default/pods/a-pod":
+				_, _ = w.Write([]byte(`{"apiVersion":"v1","kind":"Pod","metadata":{"name":"a-pod","namespace":"default"},"status":{"phase":"Running","podIP":"10.0.0.1"}}`))
+			}
+		}))
+		t.Run("resources_get with jsonpath returns projected values", func(t *testing.T) {
+			toolResult, err := c.callTool("resources_get", map[string]interface{}{
+				"apiVersion": "apps/v1", "kind": "Deployment", "namespace": "default", "name": "web", "jsonpath": "{.spec.replicas}",
+			})
+			if err != nil || toolResult.IsError {
+				t.Fatalf("call tool failed %v %v", err, toolResult.Content)
+			}
+			if toolResult.Content[0].(mcp.TextContent).Text != "2" {
+				t.Errorf("unexpected result %v", toolResult.Content[0].(mcp.TextContent).Text)
+			}
+		})
+		t.Run("resources_list with fields returns projected fields of each resource", func(t *testing.T) {
+			toolResult, err := c.callTool("resources_list", map[string]interface{}{
+				"apiVersion": "apps/v1", "kind": "Deployment", "namespace": "default",
+				"fields": []interface{}{"metadata.name", "spec.template.spec.containers[*].image"},
+			})
+			if err != nil || toolResult.IsError {
+				t.Fatalf("call tool failed %v %v", err, toolResult.Content)
+			}
+			expected := "- metadata.name: web\n  spec.template.spec.containers[*].image:\n  - nginx:1.27\n" +
+				"- metadata.name: db\n  spec.template.spec.containers[*].image:\n  - postgres:17\n  - exporter:1\n"
+			if toolResult.Content[0].(mcp.TextContent).Text != expected {
+				t.Errorf("unexpected result %v", toolResult.Content[0].(mcp.TextContent).Text)
+			}
+		})
+		t.Run("resources_list with jsonpath returns projected values", func(t *testing.T) {
+			toolResult, err := c.callTool("resources_list", map[string]interface{}{
+				"apiVersion": "apps/v1", "kind": "Deployment", "namespace": "default",
+				"jsonpath": `{range .items[*]}{.metadata.name}{"\t"}{.spec.template.spec.containers[*].image}{"\n"}{end}`,
+			})
+			if err != nil || toolResult.IsError {
+				t.Fatalf("call tool failed %v %v", err, toolResult.Content)
+			}
+			if toolResult.Content[0].(mcp.TextContent).Text != "web\tnginx:1.27\ndb\tpostgres:17 exporter:1\n" {
+				t.Errorf("unexpected result %v", toolResult.Content[0].(mcp.TextContent).Text)
+			}
+		})
+		t.Run("resources_list with jsonpath and fields returns error", func(t *testing.T) {
+			toolResult, _ := c.callTool("resources_list", map[string]interface{}{
+				"apiVersion": "apps/v1", "kind": "Deployment", "jsonpath": "{.items[*].metadata.name}", "fields": []interface{}{"metadata.name"},
+			})
+			if !toolResult.IsError {
+				t.Fatalf("call tool should fail")
+			}
+			if toolResult.Content[0].(mcp.TextContent).Text != "failed to list resources, jsonpath and fields are mutually exclusive" {
+				t.Errorf("invalid error message, got %v", toolResult.Content[0].(mcp.TextContent).Text)
+			}
+		})
+		t.Run("resources_get with invalid jsonpath returns error", func(t *testing.T) {
+			toolResult, _ := c.callTool("resources_get", map[string]interface{}{
+				"apiVersion": "apps/v1", "kind": "Deployment", "namespace": "default", "name": "web", "jsonpath": "{.spec[",
+			})
+			if !toolResult.IsError {
+				t.Fatalf("call tool should fail")
+			}
+			if !strings.HasPrefix(toolResult.Content[0].(mcp.TextContent).Text, `invalid jsonpath "{.spec["`) {
+				t.Errorf("invalid error message, got %v", toolResult.Content[0].(mcp.TextContent).Text)
+			}
+		})
+		t.Run("pods_get with fields returns projected fields", func(t *testing.T) {
+			toolResult, err := c.callTool("pods_get", map[string]interface{}{
+				"namespace": "default", "name": "a-pod", "fields": []interface{}{"status.phase", "status.podIP"},
+			})
+			if err != nil || toolResult.IsError {
+				t.Fatalf("call tool failed %v %v", err, toolResult.Content)
+			}
+			if toolResult.Content[0].(mcp.TextContent).Text != "status.phase: Running\nstatus.podIP: 10.0.0.1\n" {
+				t.Errorf("unexpected result %v", toolResult.Content[0].(mcp.TextContent).Text)
+			}
+		})
+	})
+}
@@ -0,0 +1,112 @@
+package mcp
+
+import (
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/mark3labs/mcp-go/mcp"
+
+	"github.com/manusa/kubernetes-mcp-server/pkg/config"
+)
+
+func TestResourcesResourceName(t *testing.T) {
+	deniedResourcesServer := &config.StaticConfig{DeniedResources: []config.GroupVersionKind{{Version: "v1", Kind: "Secret"}}}
+	testCaseWithContext(t, &mcpContext{staticConfig: deniedResourcesServer}, func(c *mcpContext) {
+		mockServer := NewMockServer()
+		defer mockServer.Close()
+		c.withKubeConfig(mockServer.config)
+		var requested []string
+		mockServer.Handle(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+			w.Header().Set("Content-Type", "application/json")
+			switch req.URL.Path {
+			// Request Performed by DiscoveryClient to Kube API (Get API Groups legacy -core-)
+			case "/api":
+				_, _ = w.Write([]byte(`{"kind":"APIVersions","versions":["v1"],"serverAddressByClientCIDRs":[{"clientCIDR":"0.0.0.0/0"}]}`))
+			// Request Performed by DiscoveryClient to Kube API (Get API Groups)
+			case "/apis":
+				_, _ = w.Write([]byte(`{"kind":"APIGroupList","apiVersion":"v1","groups":[` +
+					`{"name":"apps","versions":[{"groupVersion":"apps/v1","version":"v1"}],"preferredVersion":{"groupVersion":"apps/v1","version":"v1"}},` +
+					`{"name":"example.com","versions":[{"groupVersion":"example.com/v2","version":"v2"},{"groupVersion":"example.com/v1","version":"v1"}],"preferredVersion":{"groupVersion":"example.com/v2","version":"v2"}}` +
+					`]}`))
+			// Request Performed by DiscoveryClient to Kube API (Get API Resources)
+			case "/api/v1":
+				_, _ = w.Write([]byte(`{"kind":"APIResourceList","apiVersion":"v1","groupVersion":"v1","resources":[` +
+					`{"name":"services","singularName":"service","namespaced":true,"kind":"Service","verbs":["get","list"],"shortNames":["svc"]},` +
+					`{"name":"secrets","singularName":"secret","namespaced":true,"kind":"Secret","verbs":["get","list"]}` +
+					`]}`))
+			case "/apis/apps/v1":
+				_, _ = w.Write([]byte(`{"kind":"APIResourceList","apiVersion":"v1","groupVersion":"apps/v1","resources":[` +
+					`{"name":"deployments","singularName":"deployment","namespaced":true,"kind":"Deployment","verbs":["get","list"],"shortNames":["deploy"]}` +
+					`]}`))
+			case "/apis/example.com/v2", "/apis/example.com/v1":
+				_, _ = w.Write([]byte(`{"kind":"APIResourceList","apiVersion":"v1","groupVersion":"` + strings.TrimPrefix(req.URL.Path, "/apis/") + `","resources":[` +
+					`{"name":"widgets","singularName":"widget","namespaced":true,"kind":"Widget","verbs":["get","list"],"shortNames":["wdg"]}` +
+					`]}`))
+			default:
+				requested = append(requested, req.URL.Path)
+				_, _ = w.Write([]byte(`{"apiVersion":"v1","kind":"Status","status":"Success"}`))
+			}
+		}))
+		for resource, expectedPath := range map[string]string{
+			"svc":                    "/api/v1/namespaces/default/services/a-name",
+			"services":               "/api/v1/namespaces/default/services/a-name",
+			"Service":                "/api/v1/namespaces/default/services/a-name",
+			"deploy":                 "/apis/apps/v1/namespaces/default/deployments/a-name",
+			"deployments.apps":       "/apis/apps/v1/namespaces/default/deployments/a-name",
+			"deployment.v1.apps":     "/apis/apps/v1/namespaces/default/deployments/a-name",
+			"wdg":                    "/apis/example.com/v2/namespaces/default/widgets/a-name",
+			"widgets.example.com":    "/apis/example.com/v2/namespaces/default/widgets/a-name",
+			"widgets.v1.example.com": "/apis/example.com/v1/namespaces/default/widgets/a-name",
+		} {
+			t.Run("resources_get with resource "+resource+" resolves "+expectedPath, func(t *testing.T) {
+				requested = nil
+				toolResult, err := c.callTool("resources_get", map[string]interface{}{"resource": resource, "namespace": "default", "name": "a-name"})
+				if err != nil || toolResult.IsError {
+					t.Fatalf("call tool failed %v %v", err, toolResult.Content)
+				}
+				if len(requested) != 1 || requested[0] != expectedPath {
+					t.Errorf("unexpected request, expected %s, got %v", expectedPath, requested)
+				}
+			})
+		}
+		t.Run("resources_list with resource resolves the resource", func(t *testing.T) {
+			requested = nil
+			toolResult, err := c.callTool("resources_list", map[string]interface{}{"resource": "deploy", "namespace": "default"})
+			if err != nil || toolResult.IsError {
+				t.Fatalf("call tool failed %v %v", err, toolResult.Content)
+			}
+			if len(requested) == 0 || requested[len(requested)-1] != "/apis/apps/v1/namespaces/default/deployments" {
+				t.Errorf("unexpected request %v", requested)
+			}
+		})
+		t.Run("resources_delete with resource resolves the resource", func(t *testing.T) {
+			requested = nil
+			toolResult, err := c.callTool("resources_delete", map[string]interface{}{"resource": "svc", "namespace": "default", "name": "a-name"})
+			if err != nil || toolResult.IsError {
+				t.Fatalf("call tool failed %v %v", err, toolResult.Content)
+			}
+			if len(requested) != 1 || requested[0] != "/api/v1/namespaces/default/services/a-name" {
+				t.Errorf("unexpected request %v", requested)
+			}
+		})
+		t.Run("resources_get with unknown resource returns error", func(t *testing.T) {
+			toolResult, _ := c.callTool("resources_get", map[string]interface{}{"resource": "foos", "name": "a-name"})
+			if !toolResult.IsError {
+				t.Fatalf("call tool should fail")
+			}
+			if toolResult.Content[0].(mcp.TextContent).Text != `failed to get resource, the server doesn't have a resource type "foos"` {
+				t.Errorf("invalid error message, got %v", toolResult.Content[0].(mcp.TextContent).Text)
+			}
+		})
+		t.Run("resources_get with denied resource returns error", func(t *testing.T) {
+			toolResult, _ := c.callTool("resources_get", map[string]interface{}{"resource": "secrets", "name": "a-name"})
+			if !toolResult.IsError {
+				t.Fatalf("call tool should fail")
+			}
+			if toolResult.Content[0].(mcp.TextContent).Text != "failed to get resource, resource not allowed: /v1, Kind=Secret" {
+				t.Errorf("invalid error message, got %v", toolResult.Content[0].(mcp.TextContent).Text)
+			}
+		})
+	})
+}
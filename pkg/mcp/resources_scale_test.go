@@ -0,0 +1,144 @@
+package mcp
+
+import (
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"sigs.k8s.io/yaml"
+
+	"github.com/manusa/kubernetes-mcp-server/pkg/config"
+	"github.com/manusa/kubernetes-mcp-server/pkg/kubernetes"
+)
+
+func TestResourcesScale(t *testing.T) {
+	testCase(t, func(c *mcpContext) {
+		mockServer := NewMockServer()
+		defer mockServer.Close()
+		c.withKubeConfig(mockServer.config)
+		var mu sync.Mutex
+		replicas := 2
+		var scalePatch string
+		mockServer.Handle(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+			mu.Lock()
+			defer mu.Unlock()
+			w.Header().Set("Content-Type", "application/json")
+			switch req.URL.Path {
+			// Request Performed by DiscoveryClient to Kube API (Get API Groups legacy -core-)
+			case "/api":
+				_, _ = w.Write([]byte(`{"kind":"APIVersions","versions":["v1"],"serverAddressByClientCIDRs":[{"clientCIDR":"0.0.0.0/0"}]}`))
+			// Request Performed by DiscoveryClient to Kube API (Get API Groups)
+			case "/apis":
+				_, _ = w.Write([]byte(`{"kind":"APIGroupList","apiVersion":"v1","groups":[` +
+					`{"name":"apps","versions":[{"groupVersion":"apps/v1","version":"v1"}],"preferredVersion":{"groupVersion":"apps/v1","version":"v1"}}` +
+					`]}`))
+			// Request Performed by DiscoveryClient to Kube API (Get API Resources)
+			case "/apis/apps/v1":
+				_, _ = w.Write([]byte(`{"kind":"APIResourceList","apiVersion":"v1","groupVersion":"apps/v1","resources":[` +
+					`{"name":"deployments","singularName":"","namespaced":true,"kind":"Deployment","verbs":["get","list","patch"]},` +
+					`{"name":"deployments/scale","singularName":"","namespaced":true,"group":"autoscaling","version":"v1","kind":"Scale","verbs":["get","patch","update"]}` +
+					`]}`))
+			case "/apis/apps/v1/namespaces/default/deployments/web/scale":
+				if req.Method == http.MethodPatch {
+					body, _ := io.ReadAll(req.Body)
+					scalePatch = string(body)
+					_, _ = fmt.Sscanf(scalePatch, `{"spec":{"replicas":%d}}`, &replicas)
+				}
+				_, _ = w.Write([]byte(fmt.Sprintf(`{"kind":"Scale","apiVersion":"autoscaling/v1","metadata":{"name":"web","namespace":"default"},`+
+					`"spec":{"replicas":%d},"status":{"replicas":%d,"selector":"app=web"}}`, replicas, replicas)))
+			case "/apis/apps/v1/namespaces/default/deployments/web":
+				_, _ = w.Write([]byte(fmt.Sprintf(`{"kind":"Deployment","apiVersion":"apps/v1","metadata":{"name":"web","namespace":"default"},`+
+					`"spec":{"replicas":%d},"status":{"replicas":%d,"readyReplicas":%d}}`, replicas, replicas, replicas)))
+			}
+		}))
+		scaleResult := func(t *testing.T, toolResult *mcp.CallToolResult) *kubernetes.ResourcesScaleResult {
+			var result kubernetes.ResourcesScaleResult
+			if err := yaml.Unmarshal([]byte(toolResult.Content[0].(mcp.TextContent).Text), &result); err != nil {
+				t.Fatalf("invalid tool result content %v", err)
+			}
+			return &result
+		}
+		t.Run("resources_scale with missing name returns error", func(t *testing.T) {
+			toolResult, _ := c.callTool("resources_scale", map[string]interface{}{"apiVersion": "apps/v1", "kind": "Deployment"})
+			if !toolResult.IsError {
+				t.Fatalf("call tool should fail")
+			}
+			if toolResult.Content[0].(mcp.TextContent).Text != "failed to scale resource, missing argument name" {
+				t.Fatalf("invalid error message, got %v", toolResult.Content[0].(mcp.TextContent).Text)
+			}
+		})
+		t.Run("resources_scale without replicas returns current scale", func(t *testing.T) {
+			toolResult, err := c.callTool("resources_scale", map[string]interface{}{"apiVersion": "apps/v1", "kind": "Deployment", "name": "web"})
+			if err != nil || toolResult.IsError {
+				t.Fatalf("call tool failed %v %v", err, toolResult.Content)
+			}
+			if !strings.HasPrefix(toolResult.Content[0].(mcp.TextContent).Text, "# The current scale of the resource (YAML)\n") {
+				t.Errorf("unexpected result %v", toolResult.Content[0].(mcp.TextContent).Text)
+			}
+			result := scaleResult(t, toolResult)
+			if result.PreviousReplicas != 2 || result.Replicas != 2 || result.Selector != "app=web" {
+				t.Errorf("unexpected scale %v", result)
+			}
+			if scalePatch != "" {
+				t.Errorf("unexpected scale patch %v", scalePatch)
+			}
+		})
+		t.Run("resources_scale with replicas patches scale subresource", func(t *testing.T) {
+			toolResult, err := c.callTool("resources_scale", map[string]interface{}{
+				"apiVersion": "apps/v1",
+				"kind":       "Deployment",
+				"name":       "web",
+				"replicas":   5,
+				"wait":       true,
+			})
+			if err != nil || toolResult.IsError {
+				t.Fatalf("call tool failed %v %v", err, toolResult.Content)
+			}
+			if scalePatch != `{"spec":{"replicas":5}}` {
+				t.Errorf("unexpected scale patch %v", scalePatch)
+			}
+			result := scaleResult(t, toolResult)
+			if result.PreviousReplicas != 2 || result.Replicas != 5 {
+				t.Errorf("unexpected previous and new replicas %v", result)
+			}
+			if result.ReadyReplicas == nil || *result.ReadyReplicas != 5 {
+				t.Errorf("unexpected ready replicas %v", result.ReadyReplicas)
+			}
+			if result.Message != "Deployment web has 5 replicas ready" {
+				t.Errorf("unexpected message %v", result.Message)
+			}
+		})
+		t.Run("resources_scale with negative replicas returns error", func(t *testing.T) {
+			toolResult, _ := c.callTool("resources_scale", map[string]interface{}{"apiVersion": "apps/v1", "kind": "Deployment", "name": "web", "replicas": -1})
+			if !toolResult.IsError {
+				t.Fatalf("call tool should fail")
+			}
+			if toolResult.Content[0].(mcp.TextContent).Text != "failed to scale resource, invalid argument replicas: -1" {
+				t.Fatalf("invalid error message, got %v", toolResult.Content[0].(mcp.TextContent).Text)
+			}
+		})
+	})
+}
+
+func TestResourcesScaleDenied(t *testing.T) {
+	deniedResourcesServer := &config.StaticConfig{DeniedResources: []config.GroupVersionKind{{Group: "apps", Version: "v1", Kind: "Deployment"}}}
+	testCaseWithContext(t, &mcpContext{staticConfig: deniedResourcesServer}, func(c *mcpContext) {
+		c.withEnvTest()
+		resourcesScale, _ := c.callTool("resources_scale", map[string]interface{}{"apiVersion": "apps/v1", "kind": "Deployment", "name": "a-deployment", "replicas": 1})
+		t.Run("resources_scale has error", func(t *testing.T) {
+			if !resourcesScale.IsError {
+				t.Fatalf("call tool should fail")
+			}
+		})
+		t.Run("resources_scale describes denial", func(t *testing.T) {
+			expectedMessage := "failed to scale resource: resource not allowed: apps/v1, Kind=Deployment"
+			if resourcesScale.Content[0].(mcp.TextContent).Text != expectedMessage {
+				t.Fatalf("expected descriptive error '%s', got %v", expectedMessage, resourcesScale.Content[0].(mcp.TextContent).Text)
+			}
+		})
+	})
+}
@@ -0,0 +1,195 @@
+package mcp
+
+import (
+	"net/http"
+	"strings"
+	"sync/atomic"
+	"testing"
+
+	"github.com/mark3labs/mcp-go/mcp"
+)
+
+func TestResourcesTree(t *testing.T) {
+	testCase(t, func(c *mcpContext) {
+		mockServer := NewMockServer()
+		defer mockServer.Close()
+		c.withKubeConfig(mockServer.config)
+		deployment := `{"apiVersion":"apps/v1","kind":"Deployment","metadata":{"name":"web","namespace":"default","uid":"uid-deployment"},` +
+			`"status":{"replicas":2,"readyReplicas":1,"conditions":[{"type":"Available","status":"True"}]}}`
+		replicaSet := `{"apiVersion":"apps/v1","kind":"ReplicaSet","metadata":{"name":"web-1","namespace":"default","uid":"uid-rs",` +
+			`"ownerReferences":[{"apiVersion":"apps/v1","kind":"Deployment","name":"web","uid":"uid-deployment","controller":true}]},` +
+			`"status":{"replicas":2,"readyReplicas":1}}`
+		orphanReplicaSet := `{"apiVersion":"apps/v1","kind":"ReplicaSet","metadata":{"name":"orphan","namespace":"default","uid":"uid-orphan",` +
+			`"ownerReferences":[{"apiVersion":"argoproj.io/v1alpha1","kind":"Application","name":"app","uid":"uid-app","controller":true}]},` +
+			`"status":{"replicas":1,"readyReplicas":1}}`
+		pod1 := `{"apiVersion":"v1","kind":"Pod","metadata":{"name":"web-1-a","namespace":"default","uid":"uid-pod-a",` +
+			`"ownerReferences":[{"apiVersion":"apps/v1","kind":"ReplicaSet","name":"web-1","uid":"uid-rs","controller":true}]},` +
+			`"status":{"phase":"Running","conditions":[{"type":"Ready","status":"True"}]}}`
+		pod2 := `{"apiVersion":"v1","kind":"Pod","metadata":{"name":"web-1-b","namespace":"default","uid":"uid-pod-b",` +
+			`"ownerReferences":[{"apiVersion":"apps/v1","kind":"ReplicaSet","name":"web-1","uid":"uid-rs","controller":true}]},` +
+			`"status":{"phase":"Running","conditions":[{"type":"Ready","status":"False","reason":"ContainersNotReady"}]}}`
+		configMap := `{"apiVersion":"v1","kind":"ConfigMap","metadata":{"name":"web-config","namespace":"default","uid":"uid-cm",` +
+			`"ownerReferences":[{"apiVersion":"apps/v1","kind":"Deployment","name":"web","uid":"uid-deployment"}]}}`
+		orphanPod := `{"apiVersion":"v1","kind":"Pod","metadata":{"name":"orphan-a","namespace":"default","uid":"uid-orphan-pod",` +
+			`"ownerReferences":[{"apiVersion":"apps/v1","kind":"ReplicaSet","name":"orphan","uid":"uid-orphan","controller":true}]},` +
+			`"status":{"phase":"Pending"}}`
+		node := `{"apiVersion":"v1","kind":"Node","metadata":{"name":"node-1","uid":"uid-node"},"status":{"conditions":[{"type":"Ready","status":"True"}]}}`
+		mirrorPod := `{"apiVersion":"v1","kind":"Pod","metadata":{"name":"static-web-node-1","namespace":"kube-system","uid":"uid-mirror-pod",` +
+			`"ownerReferences":[{"apiVersion":"v1","kind":"Node","name":"node-1","uid":"uid-node","controller":true}]},` +
+			`"status":{"phase":"Running","conditions":[{"type":"Ready","status":"True"}]}}`
+		var clusterScoped, forbidden atomic.Bool
+		// Resources across all namespaces are only listed for cluster-scoped owners, and only their metadata
+		checkListAllNamespaces := func(req *http.Request) {
+			if !clusterScoped.Load() {
+				t.Errorf("resources across all namespaces should not be listed for namespaced owners: %s", req.URL.Path)
+			} else if !strings.Contains(req.Header.Get("Accept"), "as=PartialObjectMetadataList") {
+				t.Errorf("only the metadata of the resources should be listed for cluster-scoped owners: %s", req.URL.Path)
+			}
+		}
+		mockServer.Handle(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+			w.Header().Set("Content-Type", "application/json")
+			switch req.URL.Path {
+			// Request Performed by DiscoveryClient to Kube API (Get API Groups legacy -core-)
+			case "/api":
+				_, _ = w.Write([]byte(`{"kind":"APIVersions","versions":["v1"],"serverAddressByClientCIDRs":[{"clientCIDR":"0.0.0.0/0"}]}`))
+			// Request Performed by DiscoveryClient to Kube API (Get API Groups)
+			case "/apis":
+				_, _ = w.Write([]byte(`{"kind":"APIGroupList","apiVersion":"v1","groups":[` +
+					`{"name":"apps","versions":[{"groupVersion":"apps/v1","version":"v1"}],"preferredVersion":{"groupVersion":"apps/v1","version":"v1"}},` +
+					`{"name":"argoproj.io","versions":[{"groupVersion":"argoproj.io/v1alpha1","version":"v1alpha1"}],"preferredVersion":{"groupVersion":"argoproj.io/v1alpha1","version":"v1alpha1"}}` +
+					`]}`))
+			// Request Performed by DiscoveryClient to Kube API (Get API Resources)
+			case "/api/v1":
+				_, _ = w.Write([]byte(`{"kind":"APIResourceList","apiVersion":"v1","groupVersion":"v1","resources":[` +
+					`{"name":"pods","singularName":"pod","namespaced":true,"kind":"Pod","verbs":["get","list"],"shortNames":["po"]},` +
+					`{"name":"pods/log","singularName":"","namespaced":true,"kind":"Pod","verbs":["get"]},` +
+					`{"name":"configmaps","singularName":"configmap","namespaced":true,"kind":"ConfigMap","verbs":["get","list"]},` +
+					`{"name":"nodes","singularName":"node","namespaced":false,"kind":"Node","verbs":["get","list"]}` +
+					`]}`))
+			case "/apis/apps/v1":
+				_, _ = w.Write([]byte(`{"kind":"APIResourceList","apiVersion":"v1","groupVersion":"apps/v1","resources":[` +
+					`{"name":"deployments","singularName":"deployment","namespaced":true,"kind":"Deployment","verbs":["get","list"]},` +
+					`{"name":"replicasets","singularName":"replicaset","namespaced":true,"kind":"ReplicaSet","verbs":["get","list"]}` +
+					`]}`))
+			case "/apis/argoproj.io/v1alpha1":
+				_, _ = w.Write([]byte(`{"kind":"APIResourceList","apiVersion":"v1","groupVersion":"argoproj.io/v1alpha1","resources":[` +
+					`{"name":"applications","singularName":"application","namespaced":true,"kind":"Application","verbs":["get","list"]}` +
+					`]}`))
+			case "/apis/apps/v1/namespaces/default/deployments/web":
+				_, _ = w.Write([]byte(deployment))
+			case "/apis/apps/v1/namespaces/default/replicasets/web-1":
+				_, _ = w.Write([]byte(replicaSet))
+			case "/apis/apps/v1/namespaces/default/replicasets/orphan":
+				_, _ = w.Write([]byte(orphanReplicaSet))
+			case "/api/v1/namespaces/default/pods/web-1-b":
+				_, _ = w.Write([]byte(pod2))
+			case "/api/v1/namespaces/default/pods/orphan-a":
+				_, _ = w.Write([]byte(orphanPod))
+			case "/apis/apps/v1/namespaces/default/deployments":
+				_, _ = w.Write([]byte(`{"kind":"DeploymentList","apiVersion":"apps/v1","items":[` + deployment + `]}`))
+			case "/apis/apps/v1/namespaces/default/replicasets":
+				_, _ = w.Write([]byte(`{"kind":"ReplicaSetList","apiVersion":"apps/v1","items":[` + replicaSet + `,` + orphanReplicaSet + `]}`))
+			case "/api/v1/namespaces/default/pods":
+				_, _ = w.Write([]byte(`{"kind":"PodList","apiVersion":"v1","items":[` + pod2 + `,` + pod1 + `,` + orphanPod + `]}`))
+			case "/api/v1/namespaces/default/configmaps":
+				_, _ = w.Write([]byte(`{"kind":"ConfigMapList","apiVersion":"v1","items":[` + configMap + `]}`))
+			case "/apis/argoproj.io/v1alpha1/namespaces/default/applications":
+				if forbidden.Load() {
+					w.WriteHeader(http.StatusForbidden)
+					_, _ = w.Write([]byte(`{"kind":"Status","apiVersion":"v1","status":"Failure","reason":"Forbidden","code":403,` +
+						`"message":"applications.argoproj.io is forbidden"}`))
+					return
+				}
+				_, _ = w.Write([]byte(`{"kind":"ApplicationList","apiVersion":"argoproj.io/v1alpha1","items":[]}`))
+			case "/api/v1/nodes/node-1":
+				_, _ = w.Write([]byte(node))
+			case "/api/v1/namespaces/kube-system/pods/static-web-node-1":
+				_, _ = w.Write([]byte(mirrorPod))
+			case "/api/v1/pods":
+				checkListAllNamespaces(req)
+				_, _ = w.Write([]byte(`{"kind":"PartialObjectMetadataList","apiVersion":"meta.k8s.io/v1","items":[` +
+					`{"metadata":{"name":"static-web-node-1","namespace":"kube-system","uid":"uid-mirror-pod",` +
+					`"ownerReferences":[{"apiVersion":"v1","kind":"Node","name":"node-1","uid":"uid-node","controller":true}]}},` +
+					`{"metadata":{"name":"web-1-a","namespace":"default","uid":"uid-pod-a",` +
+					`"ownerReferences":[{"apiVersion":"apps/v1","kind":"ReplicaSet","name":"web-1","uid":"uid-rs","controller":true}]}}` +
+					`]}`))
+			case "/api/v1/nodes", "/api/v1/configmaps", "/apis/apps/v1/deployments", "/apis/apps/v1/replicasets", "/apis/argoproj.io/v1alpha1/applications":
+				checkListAllNamespaces(req)
+				_, _ = w.Write([]byte(`{"kind":"PartialObjectMetadataList","apiVersion":"meta.k8s.io/v1","items":[]}`))
+			default:
+				w.WriteHeader(http.StatusNotFound)
+				_, _ = w.Write([]byte(`{"kind":"Status","apiVersion":"v1","status":"Failure","reason":"NotFound","code":404,"message":"not found"}`))
+			}
+		}))
+		t.Run("resources_tree from pod returns tree from top-level owner", func(t *testing.T) {
+			toolResult, err := c.callTool("resources_tree", map[string]interface{}{"resource": "po", "namespace": "default", "name": "web-1-b"})
+			if err != nil || toolResult.IsError {
+				t.Fatalf("call tool failed %v %v", err, toolResult.Content)
+			}
+			expected := "# Ownership tree of the resource (marked with *), from its top-level owner down to all of its dependents\n" +
+				"Deployment.apps/web (namespace: default) [Available]\n" +
+				"├── ConfigMap/web-config [-]\n" +
+				"└── ReplicaSet.apps/web-1 [NotReady 1/2]\n" +
+				"    ├── Pod/web-1-a [Ready]\n" +
+				"    └── Pod/web-1-b [NotReady: ContainersNotReady] *\n"
+			if toolResult.Content[0].(mcp.TextContent).Text != expected {
+				t.Errorf("unexpected result, expected:\n%s\ngot:\n%s", expected, toolResult.Content[0].(mcp.TextContent).Text)
+			}
+		})
+		t.Run("resources_tree with missing owner returns tree rooted at the owner reference", func(t *testing.T) {
+			toolResult, err := c.callTool("resources_tree", map[string]interface{}{"apiVersion": "v1", "kind": "Pod", "namespace": "default", "name": "orphan-a"})
+			if err != nil || toolResult.IsError {
+				t.Fatalf("call tool failed %v %v", err, toolResult.Content)
+			}
+			expected := "# Ownership tree of the resource (marked with *), from its top-level owner down to all of its dependents\n" +
+				"Application.argoproj.io/app (namespace: default) [Unknown: not found]\n" +
+				"└── ReplicaSet.apps/orphan [Ready 1/1]\n" +
+				"    └── Pod/orphan-a [Pending] *\n"
+			if toolResult.Content[0].(mcp.TextContent).Text != expected {
+				t.Errorf("unexpected result, expected:\n%s\ngot:\n%s", expected, toolResult.Content[0].(mcp.TextContent).Text)
+			}
+		})
+		t.Run("resources_tree with resources that can't be listed returns tree and reports them", func(t *testing.T) {
+			forbidden.Store(true)
+			defer forbidden.Store(false)
+			toolResult, err := c.callTool("resources_tree", map[string]interface{}{"resource": "po", "namespace": "default", "name": "web-1-b"})
+			if err != nil || toolResult.IsError {
+				t.Fatalf("call tool failed %v %v", err, toolResult.Content)
+			}
+			expected := "# Ownership tree of the resource (marked with *), from its top-level owner down to all of its dependents\n" +
+				"Deployment.apps/web (namespace: default) [Available]\n" +
+				"├── ConfigMap/web-config [-]\n" +
+				"└── ReplicaSet.apps/web-1 [NotReady 1/2]\n" +
+				"    ├── Pod/web-1-a [Ready]\n" +
+				"    └── Pod/web-1-b [NotReady: ContainersNotReady] *\n" +
+				"# The tree may be incomplete, the dependents couldn't be discovered in the following resources (YAML)\n" +
+				"- 'applications.argoproj.io: applications.argoproj.io is forbidden'\n"
+			if toolResult.Content[0].(mcp.TextContent).Text != expected {
+				t.Errorf("unexpected result, expected:\n%s\ngot:\n%s", expected, toolResult.Content[0].(mcp.TextContent).Text)
+			}
+		})
+		t.Run("resources_tree from cluster-scoped resource returns tree with dependents in all namespaces", func(t *testing.T) {
+			clusterScoped.Store(true)
+			defer clusterScoped.Store(false)
+			toolResult, err := c.callTool("resources_tree", map[string]interface{}{"apiVersion": "v1", "kind": "Node", "name": "node-1"})
+			if err != nil || toolResult.IsError {
+				t.Fatalf("call tool failed %v %v", err, toolResult.Content)
+			}
+			expected := "# Ownership tree of the resource (marked with *), from its top-level owner down to all of its dependents\n" +
+				"Node/node-1 [Ready] *\n" +
+				"└── Pod/static-web-node-1 (namespace: kube-system) [Ready]\n"
+			if toolResult.Content[0].(mcp.TextContent).Text != expected {
+				t.Errorf("unexpected result, expected:\n%s\ngot:\n%s", expected, toolResult.Content[0].(mcp.TextContent).Text)
+			}
+		})
+		t.Run("resources_tree with missing name returns error", func(t *testing.T) {
+			toolResult, _ := c.callTool("resources_tree", map[string]interface{}{"apiVersion": "v1", "kind": "Pod"})
+			if !toolResult.IsError {
+				t.Fatalf("call tool should fail")
+			}
+			if toolResult.Content[0].(mcp.TextContent).Text != "failed to get resource tree, missing argument name" {
+				t.Errorf("invalid error message, got %v", toolResult.Content[0].(mcp.TextContent).Text)
+			}
+		})
+	})
+}
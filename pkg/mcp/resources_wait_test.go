@@ -0,0 +1,155 @@
+package mcp
+
+import (
+	"encoding/json"
+	"net/http"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/watch"
+	"sigs.k8s.io/yaml"
+
+	"github.com/manusa/kubernetes-mcp-server/pkg/config"
+	"github.com/manusa/kubernetes-mcp-server/pkg/kubernetes"
+)
+
+func TestResourcesWait(t *testing.T) {
+	testCase(t, func(c *mcpContext) {
+		mockServer := NewMockServer()
+		defer mockServer.Close()
+		c.withKubeConfig(mockServer.config)
+		pod := func(name string, phase v1.PodPhase) *v1.Pod {
+			return &v1.Pod{
+				TypeMeta:   metav1.TypeMeta{APIVersion: "v1", Kind: "Pod"},
+				ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: name, ResourceVersion: "2", Labels: map[string]string{"app": "web"}},
+				Status: v1.PodStatus{Phase: phase, Conditions: []v1.PodCondition{
+					{Type: v1.PodReady, Status: map[bool]v1.ConditionStatus{true: v1.ConditionTrue, false: v1.ConditionFalse}[phase == v1.PodRunning]},
+				}},
+			}
+		}
+		mockServer.Handle(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+			w.Header().Set("Content-Type", "application/json")
+			switch req.URL.Path {
+			// Request Performed by DiscoveryClient to Kube API (Get API Groups legacy -core-)
+			case "/api":
+				_, _ = w.Write([]byte(`{"kind":"APIVersions","versions":["v1"],"serverAddressByClientCIDRs":[{"clientCIDR":"0.0.0.0/0"}]}`))
+			// Request Performed by DiscoveryClient to Kube API (Get API Groups)
+			case "/apis":
+				_, _ = w.Write([]byte(`{"kind":"APIGroupList","apiVersion":"v1","groups":[]}`))
+			// Request Performed by DiscoveryClient to Kube API (Get API Resources)
+			case "/api/v1":
+				_, _ = w.Write([]byte(`{"kind":"APIResourceList","apiVersion":"v1","groupVersion":"v1","resources":[{"name":"pods","singularName":"","namespaced":true,"kind":"Pod","verbs":["get","list","watch"]}]}`))
+			case "/api/v1/namespaces/default/pods":
+				if req.URL.Query().Get("watch") != "true" {
+					list := &v1.PodList{TypeMeta: metav1.TypeMeta{APIVersion: "v1", Kind: "PodList"}, ListMeta: metav1.ListMeta{ResourceVersion: "1"}}
+					if req.URL.Query().Get("labelSelector") == "app=web" {
+						list.Items = []v1.Pod{*pod("running-pod", v1.PodRunning), *pod("starting-pod", v1.PodPending)}
+					} else if req.URL.Query().Get("fieldSelector") == "metadata.name=stuck-pod" {
+						list.Items = []v1.Pod{*pod("stuck-pod", v1.PodPending)}
+					}
+					writeObject(w, list)
+					return
+				}
+				if req.URL.Query().Get("labelSelector") == "app=web" {
+					raw, _ := json.Marshal(pod("starting-pod", v1.PodRunning))
+					_ = json.NewEncoder(w).Encode(&metav1.WatchEvent{Type: string(watch.Modified), Object: runtime.RawExtension{Raw: raw}})
+					w.(http.Flusher).Flush()
+				}
+				<-req.Context().Done()
+			}
+		}))
+		t.Run("resources_wait with missing for returns error", func(t *testing.T) {
+			toolResult, _ := c.callTool("resources_wait", map[string]interface{}{"apiVersion": "v1", "kind": "Pod", "name": "a-pod"})
+			if !toolResult.IsError {
+				t.Fatalf("call tool should fail")
+			}
+			if toolResult.Content[0].(mcp.TextContent).Text != "failed to wait for resources, missing argument for" {
+				t.Fatalf("invalid error message, got %v", toolResult.Content[0].(mcp.TextContent).Text)
+			}
+		})
+		t.Run("resources_wait with invalid for returns error", func(t *testing.T) {
+			toolResult, _ := c.callTool("resources_wait", map[string]interface{}{"apiVersion": "v1", "kind": "Pod", "name": "a-pod", "for": "ready"})
+			if !toolResult.IsError {
+				t.Fatalf("call tool should fail")
+			}
+			if !strings.HasPrefix(toolResult.Content[0].(mcp.TextContent).Text, `failed to wait for resources: invalid condition "ready"`) {
+				t.Fatalf("invalid error message, got %v", toolResult.Content[0].(mcp.TextContent).Text)
+			}
+		})
+		t.Run("resources_wait with label selector waits for all resources and notifies progress", func(t *testing.T) {
+			var mu sync.Mutex
+			var notifications []mcp.JSONRPCNotification
+			c.mcpClient.OnNotification(func(n mcp.JSONRPCNotification) {
+				mu.Lock()
+				defer mu.Unlock()
+				if n.Method == "notifications/progress" {
+					notifications = append(notifications, n)
+				}
+			})
+			callToolRequest := mcp.CallToolRequest{}
+			callToolRequest.Params.Name = "resources_wait"
+			callToolRequest.Params.Arguments = map[string]interface{}{"apiVersion": "v1", "kind": "Pod", "labelSelector": "app=web", "for": "condition=Ready"}
+			callToolRequest.Params.Meta = &mcp.Meta{ProgressToken: "wait-token"}
+			toolResult, err := c.mcpClient.CallTool(c.ctx, callToolRequest)
+			if err != nil || toolResult.IsError {
+				t.Fatalf("call tool failed %v %v", err, toolResult.Content)
+			}
+			var result kubernetes.ResourcesWaitResult
+			if err = yaml.Unmarshal([]byte(toolResult.Content[0].(mcp.TextContent).Text), &result); err != nil {
+				t.Fatalf("invalid tool result content %v", err)
+			}
+			if strings.Join(result.Met, ",") != "running-pod,starting-pod" || len(result.TimedOut) != 0 {
+				t.Errorf("unexpected result %v", result)
+			}
+			mu.Lock()
+			defer mu.Unlock()
+			if len(notifications) != 2 {
+				t.Fatalf("expected 2 progress notifications, got %v", notifications)
+			}
+			last := notifications[1].Params.AdditionalFields
+			if last["progressToken"] != "wait-token" || last["progress"] != float64(2) || last["total"] != float64(2) {
+				t.Errorf("unexpected progress notification %v", last)
+			}
+		})
+		t.Run("resources_wait with jsonpath reports timed out resources", func(t *testing.T) {
+			toolResult, _ := c.callTool("resources_wait", map[string]interface{}{
+				"apiVersion": "v1",
+				"kind":       "Pod",
+				"name":       "stuck-pod",
+				"for":        "jsonpath={.status.phase}=Running",
+				"timeout":    "1s",
+			})
+			if !toolResult.IsError {
+				t.Fatalf("call tool should fail")
+			}
+			expected := "failed to wait for resources, not all the resources met the condition jsonpath={.status.phase}=Running (YAML)\ntimedOut:\n- stuck-pod\n"
+			if toolResult.Content[0].(mcp.TextContent).Text != expected {
+				t.Errorf("unexpected result %v", toolResult.Content[0].(mcp.TextContent).Text)
+			}
+		})
+	})
+}
+
+func TestResourcesWaitDenied(t *testing.T) {
+	deniedResourcesServer := &config.StaticConfig{DeniedResources: []config.GroupVersionKind{{Version: "v1", Kind: "Secret"}}}
+	testCaseWithContext(t, &mcpContext{staticConfig: deniedResourcesServer}, func(c *mcpContext) {
+		c.withEnvTest()
+		resourcesWait, _ := c.callTool("resources_wait", map[string]interface{}{"apiVersion": "v1", "kind": "Secret", "name": "a-secret", "for": "delete"})
+		t.Run("resources_wait has error", func(t *testing.T) {
+			if !resourcesWait.IsError {
+				t.Fatalf("call tool should fail")
+			}
+		})
+		t.Run("resources_wait describes denial", func(t *testing.T) {
+			expectedMessage := "failed to wait for resources: resource not allowed: /v1, Kind=Secret"
+			if resourcesWait.Content[0].(mcp.TextContent).Text != expectedMessage {
+				t.Fatalf("expected descriptive error '%s', got %v", expectedMessage, resourcesWait.Content[0].(mcp.TextContent).Text)
+			}
+		})
+	})
+}
@@ -0,0 +1,179 @@
+package mcp
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+
+	"github.com/manusa/kubernetes-mcp-server/pkg/kubernetes"
+	"github.com/manusa/kubernetes-mcp-server/pkg/output"
+)
+
+func (s *Server) initRollout() []server.ServerTool {
+	return []server.ServerTool{
+		{Tool: mcp.NewTool("rollout_status",
+			mcp.WithDescription("Get the rollout status of a Kubernetes Deployment, StatefulSet, or DaemonSet in the current or provided namespace with the provided name, "+
+				"optionally waiting until the rollout completes"),
+			mcp.WithString("namespace", mcp.Description("Namespace of the workload")),
+			mcp.WithString("kind", mcp.Description("Kind of the workload"), mcp.Enum("Deployment", "StatefulSet", "DaemonSet"), mcp.Required()),
+			mcp.WithString("name", mcp.Description("Name of the workload"), mcp.Required()),
+			mcp.WithNumber("revision", mcp.Description("Revision to check the rollout status for (Optional, latest revision if not provided, only supported for StatefulSets)")),
+			mcp.WithBoolean("wait", mcp.Description("Wait until the rollout completes (Optional, defaults to false)")),
+			mcp.WithString("timeout", mcp.Description("Maximum duration to wait for the rollout to complete like 30s or 5m (Optional, defaults to 5m, only applicable when wait is true)")),
+			// Tool annotations
+			mcp.WithTitleAnnotation("Rollout: Status"),
+			mcp.WithReadOnlyHintAnnotation(true),
+			mcp.WithDestructiveHintAnnotation(false),
+			mcp.WithOpenWorldHintAnnotation(true),
+		), Handler: s.rolloutStatus},
+		{Tool: mcp.NewTool("rollout_history",
+			mcp.WithDescription("Get the rollout history (revisions) of a Kubernetes Deployment, StatefulSet, or DaemonSet in the current or provided namespace with the provided name. "+
+				"Revisions are retrieved from the ReplicaSets (Deployment) or ControllerRevisions (StatefulSet, DaemonSet) of the workload"),
+			mcp.WithString("namespace", mcp.Description("Namespace of the workload")),
+			mcp.WithString("kind", mcp.Description("Kind of the workload"), mcp.Enum("Deployment", "StatefulSet", "DaemonSet"), mcp.Required()),
+			mcp.WithString("name", mcp.Description("Name of the workload"), mcp.Required()),
+			mcp.WithNumber("revision", mcp.Description("Revision to show the details (Pod template) for (Optional, all revisions are listed if not provided)")),
+			// Tool annotations
+			mcp.WithTitleAnnotation("Rollout: History"),
+			mcp.WithReadOnlyHintAnnotation(true),
+			mcp.WithDestructiveHintAnnotation(false),
+			mcp.WithOpenWorldHintAnnotation(true),
+		), Handler: s.rolloutHistory},
+		{Tool: mcp.NewTool("rollout_undo",
+			mcp.WithDescription("Roll back a Kubernetes Deployment, StatefulSet, or DaemonSet in the current or provided namespace with the provided name to a previous revision. "+
+				"Use rollout_history to list the available revisions"),
+			mcp.WithString("namespace", mcp.Description("Namespace of the workload")),
+			mcp.WithString("kind", mcp.Description("Kind of the workload"), mcp.Enum("Deployment", "StatefulSet", "DaemonSet"), mcp.Required()),
+			mcp.WithString("name", mcp.Description("Name of the workload"), mcp.Required()),
+			mcp.WithNumber("toRevision", mcp.Description("Revision to roll back to (Optional, previous revision if not provided)")),
+			// Tool annotations
+			mcp.WithTitleAnnotation("Rollout: Undo"),
+			mcp.WithReadOnlyHintAnnotation(false),
+			mcp.WithDestructiveHintAnnotation(true),
+			mcp.WithIdempotentHintAnnotation(false),
+			mcp.WithOpenWorldHintAnnotation(true),
+		), Handler: s.rolloutUndo},
+		{Tool: mcp.NewTool("rollout_restart",
+			mcp.WithDescription("Restart a Kubernetes Deployment, StatefulSet, or DaemonSet in the current or provided namespace with the provided name. "+
+				"The Pods of the workload are replaced following its rollout strategy"),
+			mcp.WithString("namespace", mcp.Description("Namespace of the workload")),
+			mcp.WithString("kind", mcp.Description("Kind of the workload"), mcp.Enum("Deployment", "StatefulSet", "DaemonSet"), mcp.Required()),
+			mcp.WithString("name", mcp.Description("Name of the workload"), mcp.Required()),
+			// Tool annotations
+			mcp.WithTitleAnnotation("Rollout: Restart"),
+			mcp.WithReadOnlyHintAnnotation(false),
+			mcp.WithDestructiveHintAnnotation(true),
+			mcp.WithIdempotentHintAnnotation(false),
+			mcp.WithOpenWorldHintAnnotation(true),
+		), Handler: s.rolloutRestart},
+	}
+}
+
+// rolloutArguments returns the namespace, kind, and name arguments common to all the rollout tools
+func rolloutArguments(ctr mcp.CallToolRequest) (string, string, string, error) {
+	ns, _ := ctr.GetArguments()["namespace"].(string)
+	kind, ok := ctr.GetArguments()["kind"].(string)
+	if !ok || kind == "" {
+		return "", "", "", errors.New("missing argument kind")
+	}
+	name, ok := ctr.GetArguments()["name"].(string)
+	if !ok || name == "" {
+		return "", "", "", errors.New("missing argument name")
+	}
+	return ns, kind, name, nil
+}
+
+func (s *Server) rolloutStatus(ctx context.Context, ctr mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	ns, kind, name, err := rolloutArguments(ctr)
+	if err != nil {
+		return NewTextResult("", fmt.Errorf("failed to get rollout status, %v", err)), nil
+	}
+	rolloutStatusOptions := kubernetes.RolloutStatusOptions{Kind: kind, Name: name}
+	if v, ok := ctr.GetArguments()["revision"].(float64); ok {
+		rolloutStatusOptions.Revision = int64(v)
+	}
+	if v, ok := ctr.GetArguments()["wait"].(bool); ok && v {
+		rolloutStatusOptions.Timeout = 5 * time.Minute
+		if t, ok := ctr.GetArguments()["timeout"].(string); ok && t != "" {
+			if rolloutStatusOptions.Timeout, err = time.ParseDuration(t); err != nil || rolloutStatusOptions.Timeout <= 0 {
+				return NewTextResult("", fmt.Errorf("failed to get rollout status, invalid argument timeout: %s", t)), nil
+			}
+		}
+	}
+	derived, err := s.k.Derived(ctx)
+	if err != nil {
+		return nil, err
+	}
+	status, done, err := derived.RolloutStatus(ctx, ns, rolloutStatusOptions)
+	if err != nil {
+		return NewTextResult("", fmt.Errorf("failed to get rollout status of %s %s in namespace %s: %v", kind, name, ns, err)), nil
+	}
+	if done {
+		return NewTextResult(fmt.Sprintf("The rollout of %s %s is complete: %s", kind, name, status), nil), nil
+	}
+	return NewTextResult(fmt.Sprintf("The rollout of %s %s is in progress: %s", kind, name, status), nil), nil
+}
+
+func (s *Server) rolloutHistory(ctx context.Context, ctr mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	ns, kind, name, err := rolloutArguments(ctr)
+	if err != nil {
+		return NewTextResult("", fmt.Errorf("failed to get rollout history, %v", err)), nil
+	}
+	revision := int64(0)
+	if v, ok := ctr.GetArguments()["revision"].(float64); ok {
+		revision = int64(v)
+	}
+	derived, err := s.k.Derived(ctx)
+	if err != nil {
+		return nil, err
+	}
+	ret, err := derived.RolloutHistory(ctx, ns, kind, name, revision)
+	if err != nil {
+		return NewTextResult("", fmt.Errorf("failed to get rollout history of %s %s in namespace %s: %v", kind, name, ns, err)), nil
+	}
+	return NewTextResult(ret, nil), nil
+}
+
+func (s *Server) rolloutUndo(ctx context.Context, ctr mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	ns, kind, name, err := rolloutArguments(ctr)
+	if err != nil {
+		return NewTextResult("", fmt.Errorf("failed to undo rollout, %v", err)), nil
+	}
+	toRevision := int64(0)
+	if v, ok := ctr.GetArguments()["toRevision"].(float64); ok {
+		toRevision = int64(v)
+	}
+	derived, err := s.k.Derived(ctx)
+	if err != nil {
+		return nil, err
+	}
+	ret, err := derived.RolloutUndo(ctx, ns, kind, name, toRevision)
+	if err != nil {
+		return NewTextResult("", fmt.Errorf("failed to undo rollout of %s %s in namespace %s: %v", kind, name, ns, err)), nil
+	}
+	return NewTextResult(fmt.Sprintf("%s %s %s", kind, name, ret), nil), nil
+}
+
+func (s *Server) rolloutRestart(ctx context.Context, ctr mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	ns, kind, name, err := rolloutArguments(ctr)
+	if err != nil {
+		return NewTextResult("", fmt.Errorf("failed to restart rollout, %v", err)), nil
+	}
+	derived, err := s.k.Derived(ctx)
+	if err != nil {
+		return nil, err
+	}
+	ret, err := derived.RolloutRestart(ctx, ns, kind, name)
+	if err != nil {
+		return NewTextResult("", fmt.Errorf("failed to restart rollout of %s %s in namespace %s: %v", kind, name, ns, err)), nil
+	}
+	marshalledYaml, err := output.MarshalYaml(ret)
+	if err != nil {
+		err = fmt.Errorf("failed to restart rollout: %v", err)
+	}
+	return NewTextResult("# The following resource (YAML) has been restarted successfully\n"+marshalledYaml, err), nil
+}
@@ -0,0 +1,158 @@
+package mcp
+
+import (
+	"io"
+	"net/http"
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	appsv1 "k8s.io/api/apps/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+
+	"github.com/manusa/kubernetes-mcp-server/pkg/config"
+)
+
+func TestRollout(t *testing.T) {
+	testCase(t, func(c *mcpContext) {
+		mockServer := NewMockServer()
+		defer mockServer.Close()
+		c.withKubeConfig(mockServer.config)
+		var restartPatch string
+		deployment := func(name string, updatedReplicas int32, paused bool) *appsv1.Deployment {
+			return &appsv1.Deployment{
+				TypeMeta:   metav1.TypeMeta{APIVersion: "apps/v1", Kind: "Deployment"},
+				ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: name, Generation: 2},
+				Spec:       appsv1.DeploymentSpec{Replicas: ptr.To(int32(2)), Paused: paused},
+				Status: appsv1.DeploymentStatus{
+					ObservedGeneration: 2, Replicas: 2, UpdatedReplicas: updatedReplicas, AvailableReplicas: updatedReplicas,
+				},
+			}
+		}
+		mockServer.Handle(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+			w.Header().Set("Content-Type", "application/json")
+			switch req.URL.Path {
+			// Request Performed by DiscoveryClient to Kube API (Get API Groups legacy -core-)
+			case "/api":
+				_, _ = w.Write([]byte(`{"kind":"APIVersions","versions":["v1"],"serverAddressByClientCIDRs":[{"clientCIDR":"0.0.0.0/0"}]}`))
+			// Request Performed by DiscoveryClient to Kube API (Get API Groups)
+			case "/apis":
+				_, _ = w.Write([]byte(`{"kind":"APIGroupList","apiVersion":"v1","groups":[` +
+					`{"name":"apps","versions":[{"groupVersion":"apps/v1","version":"v1"}],"preferredVersion":{"groupVersion":"apps/v1","version":"v1"}}` +
+					`]}`))
+			// Request Performed by DiscoveryClient to Kube API (Get API Resources)
+			case "/apis/apps/v1":
+				_, _ = w.Write([]byte(`{"kind":"APIResourceList","apiVersion":"v1","groupVersion":"apps/v1","resources":[` +
+					`{"name":"deployments","singularName":"","namespaced":true,"kind":"Deployment","verbs":["get","list","patch"]},` +
+					`{"name":"statefulsets","singularName":"","namespaced":true,"kind":"StatefulSet","verbs":["get","list","patch"]}` +
+					`]}`))
+			case "/apis/apps/v1/namespaces/default/statefulsets/web":
+				_, _ = w.Write([]byte(`{"apiVersion":"apps/v1","kind":"StatefulSet","metadata":{"name":"web","namespace":"default","uid":"uid-web"},` +
+					`"spec":{"selector":{"matchLabels":{"app":"web"}}}}`))
+			case "/apis/apps/v1/namespaces/default/controllerrevisions":
+				_, _ = w.Write([]byte(`{"apiVersion":"apps/v1","kind":"ControllerRevisionList","items":[` +
+					`{"metadata":{"name":"web-1","namespace":"default","labels":{"app":"web"},"ownerReferences":[{"apiVersion":"apps/v1","kind":"StatefulSet","name":"web","uid":"uid-web","controller":true}]},"revision":1},` +
+					`{"metadata":{"name":"web-2","namespace":"default","labels":{"app":"web"},"ownerReferences":[{"apiVersion":"apps/v1","kind":"StatefulSet","name":"web","uid":"uid-web","controller":true}]},"revision":2}` +
+					`]}`))
+			case "/apis/apps/v1/namespaces/default/deployments/complete":
+				if req.Method == http.MethodPatch {
+					body, _ := io.ReadAll(req.Body)
+					restartPatch = string(body)
+				}
+				writeObject(w, deployment("complete", 2, false))
+			case "/apis/apps/v1/namespaces/default/deployments/progressing":
+				writeObject(w, deployment("progressing", 1, false))
+			case "/apis/apps/v1/namespaces/default/deployments/paused":
+				writeObject(w, deployment("paused", 2, true))
+			}
+		}))
+		t.Run("rollout_status with missing name returns error", func(t *testing.T) {
+			toolResult, _ := c.callTool("rollout_status", map[string]interface{}{"kind": "Deployment"})
+			if !toolResult.IsError {
+				t.Fatalf("call tool should fail")
+			}
+			if toolResult.Content[0].(mcp.TextContent).Text != "failed to get rollout status, missing argument name" {
+				t.Fatalf("invalid error message, got %v", toolResult.Content[0].(mcp.TextContent).Text)
+			}
+		})
+		t.Run("rollout_status with unsupported kind returns error", func(t *testing.T) {
+			toolResult, _ := c.callTool("rollout_status", map[string]interface{}{"kind": "Job", "name": "complete"})
+			if !toolResult.IsError {
+				t.Fatalf("call tool should fail")
+			}
+			if !strings.HasSuffix(toolResult.Content[0].(mcp.TextContent).Text, `unsupported rollout kind "Job" (supported: Deployment, StatefulSet, DaemonSet)`) {
+				t.Fatalf("invalid error message, got %v", toolResult.Content[0].(mcp.TextContent).Text)
+			}
+		})
+		t.Run("rollout_status with complete rollout returns complete", func(t *testing.T) {
+			toolResult, err := c.callTool("rollout_status", map[string]interface{}{"kind": "Deployment", "name": "complete"})
+			if err != nil || toolResult.IsError {
+				t.Fatalf("call tool failed %v %v", err, toolResult.Content)
+			}
+			if !strings.HasPrefix(toolResult.Content[0].(mcp.TextContent).Text, "The rollout of Deployment complete is complete: ") {
+				t.Errorf("unexpected result %v", toolResult.Content[0].(mcp.TextContent).Text)
+			}
+		})
+		t.Run("rollout_status with progressing rollout returns in progress", func(t *testing.T) {
+			toolResult, err := c.callTool("rollout_status", map[string]interface{}{"kind": "Deployment", "name": "progressing"})
+			if err != nil || toolResult.IsError {
+				t.Fatalf("call tool failed %v %v", err, toolResult.Content)
+			}
+			expected := "The rollout of Deployment progressing is in progress: Waiting for deployment \"progressing\" rollout to finish: 1 out of 2 new replicas have been updated..."
+			if !strings.HasPrefix(toolResult.Content[0].(mcp.TextContent).Text, expected) {
+				t.Errorf("unexpected result %v", toolResult.Content[0].(mcp.TextContent).Text)
+			}
+		})
+		t.Run("rollout_history returns workload revisions", func(t *testing.T) {
+			toolResult, err := c.callTool("rollout_history", map[string]interface{}{"kind": "StatefulSet", "name": "web"})
+			if err != nil || toolResult.IsError {
+				t.Fatalf("call tool failed %v %v", err, toolResult.Content)
+			}
+			if !regexp.MustCompile(`REVISION\s+CHANGE-CAUSE\n1\s+<none>\n2\s+<none>`).MatchString(toolResult.Content[0].(mcp.TextContent).Text) {
+				t.Errorf("unexpected result %v", toolResult.Content[0].(mcp.TextContent).Text)
+			}
+		})
+		t.Run("rollout_restart patches pod template annotation", func(t *testing.T) {
+			toolResult, err := c.callTool("rollout_restart", map[string]interface{}{"kind": "Deployment", "name": "complete"})
+			if err != nil || toolResult.IsError {
+				t.Fatalf("call tool failed %v %v", err, toolResult.Content)
+			}
+			if !strings.HasPrefix(toolResult.Content[0].(mcp.TextContent).Text, "# The following resource (YAML) has been restarted successfully\n") {
+				t.Errorf("unexpected result %v", toolResult.Content[0].(mcp.TextContent).Text)
+			}
+			if !strings.Contains(restartPatch, `"kubectl.kubernetes.io/restartedAt"`) {
+				t.Errorf("expected restartedAt annotation patch, got %v", restartPatch)
+			}
+		})
+		t.Run("rollout_restart with paused workload returns error", func(t *testing.T) {
+			toolResult, _ := c.callTool("rollout_restart", map[string]interface{}{"kind": "Deployment", "name": "paused"})
+			if !toolResult.IsError {
+				t.Fatalf("call tool should fail")
+			}
+			if !strings.HasSuffix(toolResult.Content[0].(mcp.TextContent).Text, "can't restart paused Deployment paused (resume it first)") {
+				t.Fatalf("invalid error message, got %v", toolResult.Content[0].(mcp.TextContent).Text)
+			}
+		})
+	})
+}
+
+func TestRolloutDenied(t *testing.T) {
+	deniedResourcesServer := &config.StaticConfig{DeniedResources: []config.GroupVersionKind{{Group: "apps", Version: "v1", Kind: "ReplicaSet"}}}
+	testCaseWithContext(t, &mcpContext{staticConfig: deniedResourcesServer}, func(c *mcpContext) {
+		c.withEnvTest()
+		rolloutHistory, _ := c.callTool("rollout_history", map[string]interface{}{"kind": "Deployment", "name": "a-deployment"})
+		t.Run("rollout_history has error", func(t *testing.T) {
+			if !rolloutHistory.IsError {
+				t.Fatalf("call tool should fail")
+			}
+		})
+		t.Run("rollout_history describes denial", func(t *testing.T) {
+			expectedMessage := "resource not allowed: apps/v1, Kind=ReplicaSet"
+			if !strings.HasSuffix(rolloutHistory.Content[0].(mcp.TextContent).Text, expectedMessage) {
+				t.Fatalf("expected descriptive error '%s', got %v", expectedMessage, rolloutHistory.Content[0].(mcp.TextContent).Text)
+			}
+		})
+	})
+}
@@ -0,0 +1,89 @@
+package output
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/util/jsonpath"
+)
+
+// JSONPath prints the result of evaluating the kubectl JSONPath template (e.g. '{.items[*].metadata.name}') against the object.
+// Relaxed expressions (e.g. '.spec.replicas' or 'spec.replicas') are also accepted.
+func JSONPath(obj runtime.Unstructured, template string) (string, error) {
+	p, err := parseJSONPath(template)
+	if err != nil {
+		return "", err
+	}
+	buf := new(bytes.Buffer)
+	if err = p.Execute(buf, obj.UnstructuredContent()); err != nil {
+		return "", err
+	}
+	return buf.String(), nil
+}
+
+// Fields prints the YAML representation of the provided fields (e.g. metadata.name, spec.containers[*].image) of the object,
+// or of each of its items in case of lists.
+func Fields(obj runtime.Unstructured, fields []string) (string, error) {
+	parsers := make([]*jsonpath.JSONPath, 0, len(fields))
+	for _, field := range fields {
+		p, err := parseJSONPath(field)
+		if err != nil {
+			return "", err
+		}
+		parsers = append(parsers, p)
+	}
+	project := func(content map[string]interface{}) (map[string]interface{}, error) {
+		ret := make(map[string]interface{}, len(fields))
+		for i, field := range fields {
+			results, err := parsers[i].FindResults(content)
+			if err != nil {
+				return nil, err
+			}
+			values := make([]interface{}, 0)
+			for _, result := range results {
+				for _, value := range result {
+					values = append(values, value.Interface())
+				}
+			}
+			// Wildcard and recursive descent expressions always return a list, even if there's a single match
+			if len(values) == 1 && !strings.ContainsAny(field, "*?") && !strings.Contains(field, "..") {
+				ret[field] = values[0]
+			} else if len(values) > 0 {
+				ret[field] = values
+			} else {
+				ret[field] = nil
+			}
+		}
+		return ret, nil
+	}
+	if list, ok := obj.(*unstructured.UnstructuredList); ok {
+		ret := make([]map[string]interface{}, 0, len(list.Items))
+		for _, item := range list.Items {
+			projected, err := project(item.Object)
+			if err != nil {
+				return "", err
+			}
+			ret = append(ret, projected)
+		}
+		return MarshalYaml(ret)
+	}
+	ret, err := project(obj.UnstructuredContent())
+	if err != nil {
+		return "", err
+	}
+	return MarshalYaml(ret)
+}
+
+func parseJSONPath(template string) (*jsonpath.JSONPath, error) {
+	if !strings.Contains(template, "{") {
+		template = "{." + strings.TrimPrefix(strings.TrimSpace(template), ".") + "}"
+	}
+	p := jsonpath.New("jsonpath").AllowMissingKeys(true)
+	if err := p.Parse(template); err != nil {
+		return nil, fmt.Errorf("invalid jsonpath %q: %v", template, err)
+	}
+	return p, nil
+}
@@ -0,0 +1,77 @@
+package output
+
+import (
+	"encoding/json"
+	"testing"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+func TestJSONPath(t *testing.T) {
+	var deploymentList unstructured.UnstructuredList
+	_ = json.Unmarshal([]byte(`
+			{ "apiVersion": "apps/v1", "kind": "DeploymentList", "items": [
+			  { "apiVersion": "apps/v1", "kind": "Deployment", "metadata": { "name": "deployment-1" }, "spec": { "replicas": 1 } },
+			  { "apiVersion": "apps/v1", "kind": "Deployment", "metadata": { "name": "deployment-2" }, "spec": { "replicas": 3 } }
+			]}`), &deploymentList)
+	t.Run("evaluates template", func(t *testing.T) {
+		out, err := JSONPath(&deploymentList, "{.items[*].metadata.name}")
+		if err != nil || out != "deployment-1 deployment-2" {
+			t.Errorf("unexpected output %q %v", out, err)
+		}
+	})
+	t.Run("evaluates range template", func(t *testing.T) {
+		out, err := JSONPath(&deploymentList, `{range .items[*]}{.metadata.name}={.spec.replicas}{"\n"}{end}`)
+		if err != nil || out != "deployment-1=1\ndeployment-2=3\n" {
+			t.Errorf("unexpected output %q %v", out, err)
+		}
+	})
+	t.Run("evaluates relaxed expression", func(t *testing.T) {
+		out, err := JSONPath(&deploymentList.Items[1], "spec.replicas")
+		if err != nil || out != "3" {
+			t.Errorf("unexpected output %q %v", out, err)
+		}
+	})
+	t.Run("ignores missing keys", func(t *testing.T) {
+		out, err := JSONPath(&deploymentList.Items[0], "{.status.readyReplicas}")
+		if err != nil || out != "" {
+			t.Errorf("unexpected output %q %v", out, err)
+		}
+	})
+	t.Run("returns error for invalid template", func(t *testing.T) {
+		if _, err := JSONPath(&deploymentList, "{.items[*"); err == nil {
+			t.Errorf("expected error")
+		}
+	})
+}
+
+func TestFields(t *testing.T) {
+	var podList unstructured.UnstructuredList
+	_ = json.Unmarshal([]byte(`
+			{ "apiVersion": "v1", "kind": "PodList", "items": [
+			  { "apiVersion": "v1", "kind": "Pod", "metadata": { "name": "pod-1" },
+			    "spec": { "containers": [{ "name": "c-1", "image": "nginx" }, { "name": "c-2", "image": "busybox" }] } },
+			  { "apiVersion": "v1", "kind": "Pod", "metadata": { "name": "pod-2" },
+			    "spec": { "containers": [{ "name": "c-1", "image": "redis" }] }, "status": { "phase": "Running" } }
+			]}`), &podList)
+	t.Run("projects fields of each list item", func(t *testing.T) {
+		out, err := Fields(&podList, []string{"metadata.name", "spec.containers[*].image", "status.phase"})
+		expected := "- metadata.name: pod-1\n" +
+			"  spec.containers[*].image:\n  - nginx\n  - busybox\n" +
+			"  status.phase: null\n" +
+			"- metadata.name: pod-2\n" +
+			"  spec.containers[*].image:\n  - redis\n" +
+			"  status.phase: Running\n"
+		if err != nil || out != expected {
+			t.Errorf("unexpected output %q %v", out, err)
+		}
+	})
+	t.Run("projects fields of object", func(t *testing.T) {
+		out, err := Fields(&podList.Items[1], []string{".metadata.name", "{.spec.containers[0]}"})
+		expected := ".metadata.name: pod-2\n" +
+			"'{.spec.containers[0]}':\n  image: redis\n  name: c-1\n"
+		if err != nil || out != expected {
+			t.Errorf("unexpected output %q %v", out, err)
+		}
+	})
+}